@@ -2,6 +2,8 @@ package edgecenter
 
 import (
 	"fmt"
+	"net/http"
+	"time"
 
 	dnsSDK "github.com/Edge-Center/edgecenter-dns-sdk-go"
 	storageSDK "github.com/Edge-Center/edgecenter-storage-sdk-go"
@@ -18,6 +20,53 @@ type Config struct {
 	CDNClient      cdn.ClientService
 	StorageClient  *storageSDK.SDK
 	DNSClient      *dnsSDK.Client
+	Features       Features
+	// NamePrefix is added to the names of the resources created by the provider.
+	NamePrefix string
+	// MetadataStamp is added to the metadata of the resources created by the provider, its keys have the configured prefix.
+	MetadataStamp map[string]string
+	// Telemetry records the cloud API calls when it is enabled, otherwise it is nil.
+	Telemetry *apiTelemetry
+	// Retry is the policy of retrying the cloud API requests failed with 429 or 5xx.
+	Retry RetryPolicy
+	// SecurityGroupNames caches the security group IDs by name, see resolveSecurityGroupIDs.
+	SecurityGroupNames *securityGroupNameCache
+}
+
+// RetryPolicy holds the retries and the exponential backoff bounds of the cloud API requests.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries of a failed request, zero disables the retries.
+	MaxRetries int
+	WaitMin    time.Duration
+	WaitMax    time.Duration
+}
+
+// DefaultRetryPolicy returns the policy used when the retry arguments of the provider are not set.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		WaitMin:    1 * time.Second,
+		WaitMax:    30 * time.Second,
+	}
+}
+
+// Features holds opt-in behaviors of the provider configured with the features block.
+type Features struct {
+	// PurgePortsOnInstanceDelete deletes floating IPs and reserved fixed IPs of the instance together with it.
+	PurgePortsOnInstanceDelete bool
+	// RecreateSecretOnExpiry replaces the secret once it has expired.
+	RecreateSecretOnExpiry bool
+	// DetachVolumesBeforeDelete detaches the volume from all instances before deleting it.
+	DetachVolumesBeforeDelete bool
+}
+
+// DefaultFeatures returns the behaviors used when the features block is not set.
+func DefaultFeatures() Features {
+	return Features{
+		PurgePortsOnInstanceDelete: false,
+		RecreateSecretOnExpiry:     false,
+		DetachVolumesBeforeDelete:  true,
+	}
 }
 
 func NewConfig(
@@ -37,17 +86,33 @@ func NewConfig(
 		CDNClient:      cdnClient,
 		StorageClient:  storageClient,
 		DNSClient:      dnsClient,
+		Features:       DefaultFeatures(),
+		Retry:          DefaultRetryPolicy(),
+
+		SecurityGroupNames: newSecurityGroupNameCache(),
 	}
 }
 
 func (c *Config) newCloudClient() (*edgecloudV2.Client, error) {
-	cloudClient, err := edgecloudV2.NewWithRetries(nil,
+	opts := []edgecloudV2.ClientOpt{
 		edgecloudV2.SetUserAgent(c.UserAgent),
 		edgecloudV2.SetAPIKey(c.PermanentToken),
 		edgecloudV2.SetBaseURL(c.CloudBaseURL),
-	)
+	}
+	if c.Retry.MaxRetries > 0 {
+		opts = append(opts, edgecloudV2.WithRetryAndBackoffs(edgecloudV2.RetryConfig{
+			RetryMax:     c.Retry.MaxRetries,
+			RetryWaitMin: edgecloudV2.PtrTo(c.Retry.WaitMin.Seconds()),
+			RetryWaitMax: edgecloudV2.PtrTo(c.Retry.WaitMax.Seconds()),
+		}))
+	}
+	// a new http client, the telemetry must not wrap the transport of http.DefaultClient
+	cloudClient, err := edgecloudV2.New(&http.Client{}, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error from creating cloud client: %w", err)
 	}
+	if c.Telemetry != nil {
+		cloudClient.HTTPClient.Transport = c.Telemetry.transport(cloudClient.HTTPClient.Transport)
+	}
 	return cloudClient, nil
 }
@@ -0,0 +1,109 @@
+package edgecenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	DNSResolutionDataSource = "edgecenter_dns_resolution"
+
+	DNSResolutionSchemaNameserver = "nameserver"
+	DNSResolutionSchemaValues     = "values"
+)
+
+func dataSourceDNSResolution() *schema.Resource {
+	types := make([]string, 0, len(dnsQueryTypes))
+	for t := range dnsQueryTypes {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	return &schema.Resource{
+		ReadContext: dataSourceDNSResolutionRead,
+		Description: "Represent the records of a domain as they are served by the authoritative nameservers of the zone. " +
+			"Can be used to verify that the DNS zone records are applied, e.g. in a check block or a CI gate.",
+		Schema: map[string]*schema.Schema{
+			DNSZoneRecordSchemaZone: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The DNS zone, whose nameservers are queried.",
+			},
+			DNSZoneRecordSchemaDomain: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The domain to be resolved, e.g. 'www.example.com'.",
+			},
+			DNSZoneRecordSchemaType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  fmt.Sprintf("The type of the records, one of %s.", strings.Join(types, ", ")),
+				ValidateFunc: validation.StringInSlice(types, true),
+			},
+			DNSResolutionSchemaNameserver: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "The nameserver to be queried, in the 'host:port' format. " +
+					"By default, the NS records of the zone are looked up and the first nameserver that answers is used.",
+			},
+			DNSResolutionSchemaValues: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The values of the records in the format of the DNS zone record content, e.g. '10 mail.example.com.' for MX. Empty if the domain doesn't exist.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			DNSZoneRecordSchemaTTL: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The lowest TTL of the records in seconds.",
+			},
+		},
+	}
+}
+
+func dataSourceDNSResolutionRead(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start DNS resolution reading")
+
+	zone := d.Get(DNSZoneRecordSchemaZone).(string)
+	domain := d.Get(DNSZoneRecordSchemaDomain).(string)
+	recordType := strings.ToUpper(d.Get(DNSZoneRecordSchemaType).(string))
+
+	nameservers := []string{d.Get(DNSResolutionSchemaNameserver).(string)}
+	if nameservers[0] == "" {
+		var err error
+		nameservers, err = zoneNameservers(ctx, zone)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var errs []error
+	for _, nameserver := range nameservers {
+		answer, err := queryDNS(ctx, nameserver, domain, dnsQueryTypes[recordType])
+		if err != nil {
+			log.Printf("[WARN] %s", err)
+			errs = append(errs, err)
+			continue
+		}
+
+		d.SetId(fmt.Sprintf("%s/%s/%s", zone, domain, recordType))
+		d.Set(DNSResolutionSchemaNameserver, nameserver)
+		d.Set(DNSResolutionSchemaValues, answer.Values)
+		d.Set(DNSZoneRecordSchemaTTL, answer.TTL)
+
+		log.Println("[DEBUG] Finish DNS resolution reading")
+
+		return nil
+	}
+
+	return diag.Errorf("cannot resolve %s %s: %s", recordType, domain, errors.Join(errs...))
+}
@@ -94,6 +94,21 @@ allowing it to have a static public IP address. The floating IP can be re-associ
 				Computed:    true,
 				Description: "The fixed (reserved) IP address that is associated with the floating IP.",
 			},
+			"attached": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the floating IP is attached to a port. Can be used to find idle floating IPs.",
+			},
+			"last_attached_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The timestamp when the floating IP was attached to the current port, empty if the floating IP is not attached.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The timestamp when the floating IP was updated, e.g. detached from a port.",
+			},
 			"router_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -175,6 +190,13 @@ func dataSourceFloatingIPRead(ctx context.Context, d *schema.ResourceData, m int
 	d.Set("region_id", foundFloatingIP.RegionID)
 	d.Set("status", foundFloatingIP.Status)
 	d.Set("port_id", foundFloatingIP.PortID)
+	d.Set("attached", foundFloatingIP.PortID != "")
+	if foundFloatingIP.PortID != "" {
+		d.Set("last_attached_at", foundFloatingIP.UpdatedAt)
+	} else {
+		d.Set("last_attached_at", "")
+	}
+	d.Set("updated_at", foundFloatingIP.UpdatedAt)
 	if foundFloatingIP.Instance.ID != "" {
 		d.Set("instance_id_attached_to", foundFloatingIP.Instance.ID)
 	}
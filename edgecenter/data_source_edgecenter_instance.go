@@ -180,17 +180,21 @@ func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	name := d.Get("name").(string)
 
-	insts, _, err := clientV2.Instances.List(ctx, &edgecloudV2.InstanceListOptions{Name: name})
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	var found bool
 	var instance edgecloudV2.Instance
-	for _, l := range insts {
-		if l.Name == name {
-			instance = l
-			found = true
+	for _, instanceName := range prefixedNames(m, name) {
+		insts, _, err := clientV2.Instances.List(ctx, &edgecloudV2.InstanceListOptions{Name: instanceName})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, l := range insts {
+			if l.Name == instanceName {
+				instance = l
+				found = true
+				break
+			}
+		}
+		if found {
 			break
 		}
 	}
@@ -200,7 +204,7 @@ func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	d.SetId(instance.ID)
-	d.Set("name", instance.Name)
+	d.Set("name", name)
 	d.Set("flavor_id", instance.Flavor.FlavorID)
 	d.Set("status", instance.Status)
 	d.Set("vm_state", instance.VMState)
@@ -145,6 +145,16 @@ func dataSourceInstanceV2() *schema.Resource {
 				Computed:    true,
 				Description: "The current status of the instance. This is computed automatically and can be used to track the instance's state.",
 			},
+			InstanceFirstIPv4AddressField: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The first IPv4 address of the instance interfaces, e.g. to be used as an lbmember address.",
+			},
+			InstanceFirstIPv6AddressField: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The first IPv6 address of the instance interfaces.",
+			},
 			InstanceVMStateField: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -166,17 +176,21 @@ func dataSourceInstanceV2Read(ctx context.Context, d *schema.ResourceData, m int
 
 	name := d.Get(NameField).(string)
 
-	insts, _, err := clientV2.Instances.List(ctx, &edgecloudV2.InstanceListOptions{Name: name})
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	var found bool
 	var instance edgecloudV2.Instance
-	for _, l := range insts {
-		if l.Name == name {
-			instance = l
-			found = true
+	for _, instanceName := range prefixedNames(m, name) {
+		insts, _, err := clientV2.Instances.List(ctx, &edgecloudV2.InstanceListOptions{Name: instanceName})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, l := range insts {
+			if l.Name == instanceName {
+				instance = l
+				found = true
+				break
+			}
+		}
+		if found {
 			break
 		}
 	}
@@ -186,7 +200,7 @@ func dataSourceInstanceV2Read(ctx context.Context, d *schema.ResourceData, m int
 	}
 
 	d.SetId(instance.ID)
-	d.Set(NameField, instance.Name)
+	d.Set(NameField, name)
 	d.Set(FlavorIDField, instance.Flavor.FlavorID)
 	d.Set(StatusField, instance.Status)
 	d.Set(InstanceVMStateField, instance.VMState)
@@ -247,6 +261,10 @@ func dataSourceInstanceV2Read(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
+	firstIPv4, firstIPv6 := instanceFirstIPAddresses(ifs)
+	d.Set(InstanceFirstIPv4AddressField, firstIPv4)
+	d.Set(InstanceFirstIPv6AddressField, firstIPv6)
+
 	metadata := make(map[string]interface{}, len(instance.Metadata))
 	for key, value := range instance.Metadata {
 		metadata[key] = value
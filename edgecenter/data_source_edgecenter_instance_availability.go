@@ -0,0 +1,203 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+// instanceQuotaChecks are the regional quotas checked before instances are created, the key of the limit and the usage.
+var instanceQuotaChecks = []struct {
+	name, limit, usage string
+}{
+	{"instances", "vm_count_limit", "vm_count_usage"},
+	{"vCPUs", "cpu_count_limit", "cpu_count_usage"},
+	{"RAM", "ram_limit", "ram_usage"},
+}
+
+func dataSourceInstanceAvailability() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInstanceAvailabilityRead,
+		Description: "Represent whether the instances of the flavor can be created in the region at the moment: the flavor is enabled, " +
+			"there are free bare metal nodes of the flavor and the regional quotas are not exceeded. " +
+			"Can be used by modules to fall back to an alternate flavor.",
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"project_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"region_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{"region_id", "region_name"},
+			},
+			"region_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{"region_id", "region_name"},
+			},
+			"flavor_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the instance or bare metal flavor.",
+			},
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				Description:  "The number of instances to be created.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"available": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the instances of the flavor can be created.",
+			},
+			"reason": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The reason why the instances cannot be created, empty if they can.",
+			},
+			"baremetal": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the flavor is a bare metal flavor.",
+			},
+			"vcpus": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of vCPUs of the flavor.",
+			},
+			"ram": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The amount of RAM of the flavor in MiB.",
+			},
+		},
+	}
+}
+
+func dataSourceInstanceAvailabilityRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start instance availability reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flavorID := d.Get("flavor_id").(string)
+	count := d.Get("instance_count").(int)
+
+	flavor, baremetal, err := findInstanceFlavor(ctx, clientV2, flavorID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	reason, err := instanceUnavailabilityReason(ctx, clientV2, flavor, baremetal, count)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d:%d:%s:%d", clientV2.Project, clientV2.Region, flavorID, count))
+	d.Set("available", reason == "")
+	d.Set("reason", reason)
+	d.Set("baremetal", baremetal)
+	d.Set("vcpus", flavor.VCPUS)
+	d.Set("ram", flavor.RAM)
+
+	log.Println("[DEBUG] Finish instance availability reading")
+
+	return nil
+}
+
+// findInstanceFlavor looks up the flavor among the instance and the bare metal flavors of the region, including the disabled ones.
+func findInstanceFlavor(ctx context.Context, client *edgecloudV2.Client, flavorID string) (*edgecloudV2.Flavor, bool, error) {
+	opts := &edgecloudV2.FlavorListOptions{Disabled: true}
+
+	flavors, _, err := client.Flavors.List(ctx, opts)
+	if err != nil {
+		return nil, false, fmt.Errorf("cannot get flavors: %w", err)
+	}
+	for i := range flavors {
+		if flavors[i].FlavorID == flavorID {
+			return &flavors[i], false, nil
+		}
+	}
+
+	bmFlavors, _, err := client.Flavors.ListBaremetal(ctx, opts)
+	if err != nil {
+		return nil, false, fmt.Errorf("cannot get bare metal flavors: %w", err)
+	}
+	for i := range bmFlavors {
+		if bmFlavors[i].FlavorID == flavorID {
+			return &bmFlavors[i], true, nil
+		}
+	}
+
+	return nil, false, fmt.Errorf("flavor %s not found", flavorID)
+}
+
+// instanceUnavailabilityReason returns why count instances of the flavor cannot be created, or an empty string if they can.
+func instanceUnavailabilityReason(ctx context.Context, client *edgecloudV2.Client, flavor *edgecloudV2.Flavor, baremetal bool, count int) (string, error) {
+	if flavor.Disabled {
+		return fmt.Sprintf("flavor %s is disabled", flavor.FlavorID), nil
+	}
+
+	if baremetal {
+		capacity, _, err := client.Instances.BareMetalGetCountAvailableNodes(ctx)
+		if err != nil {
+			return "", fmt.Errorf("cannot get bare metal capacity: %w", err)
+		}
+		if free := capacity.Capacity[flavor.FlavorID]; free < count {
+			return fmt.Sprintf("%d bare metal nodes of flavor %s are available, %d requested", free, flavor.FlavorID, count), nil
+		}
+
+		return "", nil
+	}
+
+	quotas, _, err := client.Quotas.ListCombined(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("cannot get quotas: %w", err)
+	}
+	var regionalQuota edgecloudV2.Quota
+	for _, quota := range quotas.RegionalQuotas {
+		if quota["region_id"] == client.Region {
+			regionalQuota = quota
+			break
+		}
+	}
+
+	required := map[string]int{
+		"instances": count,
+		"vCPUs":     flavor.VCPUS * count,
+		"RAM":       flavor.RAM * count,
+	}
+	for _, check := range instanceQuotaChecks {
+		limit, okLimit := regionalQuota[check.limit]
+		usage, okUsage := regionalQuota[check.usage]
+		if !okLimit || !okUsage || limit < 0 {
+			continue
+		}
+		if free := limit - usage; free < required[check.name] {
+			return fmt.Sprintf("quota exceeded for %s: %d available, %d requested", check.name, free, required[check.name]), nil
+		}
+	}
+
+	return "", nil
+}
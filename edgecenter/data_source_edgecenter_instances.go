@@ -0,0 +1,323 @@
+package edgecenter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	InstancesDataSource = "edgecenter_instances"
+
+	InstancesNameRegexField           = "name_regex"
+	InstancesSecurityGroupIDField     = "security_group_id"
+	InstancesField                    = "instances"
+	InstancesAddressesField           = "addresses"
+	InstancesVolumesField             = "volumes"
+	InstancesAddressField             = "address"
+	InstancesSubnetNameField          = "subnet_name"
+	InstancesDeleteOnTerminationField = "delete_on_termination"
+)
+
+func dataSourceInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInstancesRead,
+		Description: "Represent the list of instances matching the filters. Can be used to feed dynamic blocks " +
+			"or for_each of other resources without hardcoding the instance IDs.",
+		Schema: map[string]*schema.Schema{
+			ProjectIDField: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{ProjectIDField, ProjectNameField},
+			},
+			ProjectNameField: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{ProjectIDField, ProjectNameField},
+			},
+			RegionIDField: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+			},
+			RegionNameField: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+			},
+			InstancesNameRegexField: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Filter by the regular expression, which the instance name must match, e.g. '^web-'.",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			StatusField: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter by the status of the instances, e.g. 'ACTIVE' or 'SHUTOFF'.",
+			},
+			FlavorIDField: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter by the ID of the flavor, e.g. 'g1-standard-2-4'.",
+			},
+			MetadataField: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Filter by the metadata, the instances must have all the key-value pairs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			InstancesSecurityGroupIDField: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Filter by the ID of the security group assigned to the instances.",
+				ValidateFunc: validation.IsUUID,
+			},
+			InstancesField: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of the instances sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						IDField: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the instance.",
+						},
+						NameField: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the instance.",
+						},
+						FlavorIDField: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the flavor of the instance.",
+						},
+						StatusField: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The current status of the instance.",
+						},
+						InstanceVMStateField: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The current virtual machine state of the instance.",
+						},
+						MetadataField: {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "A map containing metadata, for example tags.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						SecurityGroupsField: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The names of the security groups assigned to the instance.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						InstancesAddressesField: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "A list of the addresses of the instance.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									NetworkNameField: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the network.",
+									},
+									SubnetIDField: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "ID of the subnet.",
+									},
+									InstancesSubnetNameField: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Name of the subnet.",
+									},
+									TypeField: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Type of the address, 'fixed' or 'floating'.",
+									},
+									InstancesAddressField: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "IP address.",
+									},
+								},
+							},
+						},
+						InstancesVolumesField: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "A list of the volumes attached to the instance.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									InstanceVolumeIDField: {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The ID of the volume.",
+									},
+									InstancesDeleteOnTerminationField: {
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Whether the volume is deleted with the instance.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Instances reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := &edgecloudV2.InstanceListOptions{
+		Status:   d.Get(StatusField).(string),
+		FlavorID: d.Get(FlavorIDField).(string),
+	}
+	if metadata := d.Get(MetadataField).(map[string]interface{}); len(metadata) > 0 {
+		metadataKV, err := json.Marshal(metadata)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		opts.MetadataKV = string(metadataKV)
+	}
+
+	instances, _, err := clientV2.Instances.List(ctx, opts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var inSecurityGroup map[string]bool
+	if securityGroupID := d.Get(InstancesSecurityGroupIDField).(string); securityGroupID != "" {
+		sgInstances, _, err := clientV2.Instances.FilterBySecurityGroup(ctx, securityGroupID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		inSecurityGroup = make(map[string]bool, len(sgInstances))
+		for _, instance := range sgInstances {
+			inSecurityGroup[instance.ID] = true
+		}
+	}
+
+	var nameRegex *regexp.Regexp
+	if pattern := d.Get(InstancesNameRegexField).(string); pattern != "" {
+		nameRegex = regexp.MustCompile(pattern)
+	}
+
+	filtered := make([]edgecloudV2.Instance, 0, len(instances))
+	for _, instance := range instances {
+		if nameRegex != nil && !nameRegex.MatchString(instance.Name) {
+			continue
+		}
+		if inSecurityGroup != nil && !inSecurityGroup[instance.ID] {
+			continue
+		}
+		filtered = append(filtered, instance)
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if filtered[i].Name == filtered[j].Name {
+			return filtered[i].ID < filtered[j].ID
+		}
+		return filtered[i].Name < filtered[j].Name
+	})
+
+	result := make([]map[string]interface{}, 0, len(filtered))
+	for _, instance := range filtered {
+		result = append(result, flattenInstancesInstance(instance))
+	}
+
+	d.SetId(fmt.Sprintf("%d:%d", clientV2.Project, clientV2.Region))
+	if err := d.Set(InstancesField, result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Println("[DEBUG] Finish Instances reading")
+
+	return nil
+}
+
+func flattenInstancesInstance(instance edgecloudV2.Instance) map[string]interface{} {
+	var flavorID string
+	if instance.Flavor != nil {
+		flavorID = instance.Flavor.FlavorID
+	}
+
+	metadata := make(map[string]interface{}, len(instance.Metadata))
+	for key, value := range instance.Metadata {
+		metadata[key] = value
+	}
+
+	securityGroups := make([]string, 0, len(instance.SecurityGroups))
+	for _, sg := range instance.SecurityGroups {
+		securityGroups = append(securityGroups, sg.Name)
+	}
+
+	networkNames := make([]string, 0, len(instance.Addresses))
+	for networkName := range instance.Addresses {
+		networkNames = append(networkNames, networkName)
+	}
+	sort.Strings(networkNames)
+	addresses := make([]map[string]interface{}, 0)
+	for _, networkName := range networkNames {
+		for _, address := range instance.Addresses[networkName] {
+			addresses = append(addresses, map[string]interface{}{
+				NetworkNameField:         networkName,
+				SubnetIDField:            address.SubnetID,
+				InstancesSubnetNameField: address.SubnetName,
+				TypeField:                address.Type,
+				InstancesAddressField:    address.Address.String(),
+			})
+		}
+	}
+
+	volumes := make([]map[string]interface{}, 0, len(instance.Volumes))
+	for _, volume := range instance.Volumes {
+		volumes = append(volumes, map[string]interface{}{
+			InstanceVolumeIDField:             volume.ID,
+			InstancesDeleteOnTerminationField: volume.DeleteOnTermination,
+		})
+	}
+
+	return map[string]interface{}{
+		IDField:                 instance.ID,
+		NameField:               instance.Name,
+		FlavorIDField:           flavorID,
+		StatusField:             instance.Status,
+		InstanceVMStateField:    instance.VMState,
+		MetadataField:           metadata,
+		SecurityGroupsField:     securityGroups,
+		InstancesAddressesField: addresses,
+		InstancesVolumesField:   volumes,
+	}
+}
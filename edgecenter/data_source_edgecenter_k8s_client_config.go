@@ -52,6 +52,7 @@ func dataSourceK8sClientConfig() *schema.Resource {
 			"client_key_data": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "The client_key_data field from k8s config.",
 			},
 		},
@@ -0,0 +1,78 @@
+package edgecenter
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Edge-Center/edgecentercloud-go/edgecenter/k8s/v1/clusters"
+)
+
+func dataSourceK8sVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceK8sVersionsRead,
+		Description: "Represent the list of Kubernetes versions available for k8s clusters. " +
+			"Can be used to check the version of a cluster before creating or upgrading it.",
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"project_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"region_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{"region_id", "region_name"},
+			},
+			"region_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{"region_id", "region_name"},
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of the available Kubernetes versions.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceK8sVersionsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start K8s versions reading")
+	var diags diag.Diagnostics
+	config := m.(*Config)
+	provider := config.Provider
+
+	client, err := CreateClient(provider, d, K8sPoint, VersionPointV1)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	versions, err := clusters.VersionsAll(client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(client.RegionID))
+	if err := d.Set("versions", versions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Println("[DEBUG] Finish K8s versions reading")
+
+	return diags
+}
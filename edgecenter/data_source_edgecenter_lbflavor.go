@@ -0,0 +1,132 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func dataSourceLBFlavor() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBFlavorRead,
+		Description: "Represent the smallest load balancer flavor that satisfies the requested resources. " +
+			"Can be used to keep modules independent of the flavor names of a region.",
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"project_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"region_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{"region_id", "region_name"},
+			},
+			"region_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{"region_id", "region_name"},
+			},
+			"min_vcpus": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "The minimum number of vCPUs of the flavor.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"min_ram": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "The minimum amount of RAM of the flavor in MiB.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"flavor_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the flavor. It can be used in the 'flavor' field of the load balancer.",
+			},
+			"vcpus": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of vCPUs of the flavor.",
+			},
+			"ram": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The amount of RAM of the flavor in MiB.",
+			},
+			"network": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The network hardware description of the flavor.",
+			},
+		},
+	}
+}
+
+func dataSourceLBFlavorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start LB flavor reading")
+	var diags diag.Diagnostics
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flavors, _, err := clientV2.Loadbalancers.FlavorList(ctx, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	minVCPUs := d.Get("min_vcpus").(int)
+	minRAM := d.Get("min_ram").(int)
+
+	var suitable []edgecloudV2.Flavor
+	for _, flavor := range flavors {
+		if flavor.Disabled || flavor.VCPUS < minVCPUs || flavor.RAM < minRAM {
+			continue
+		}
+		suitable = append(suitable, flavor)
+	}
+	if len(suitable) == 0 {
+		return diag.FromErr(fmt.Errorf("there are no load balancer flavors with at least %d vCPUs and %d MiB of RAM", minVCPUs, minRAM))
+	}
+
+	sort.Slice(suitable, func(i, j int) bool {
+		if suitable[i].VCPUS != suitable[j].VCPUS {
+			return suitable[i].VCPUS < suitable[j].VCPUS
+		}
+		if suitable[i].RAM != suitable[j].RAM {
+			return suitable[i].RAM < suitable[j].RAM
+		}
+		return suitable[i].FlavorName < suitable[j].FlavorName
+	})
+	flavor := suitable[0]
+
+	d.SetId(flavor.FlavorID)
+	d.Set("flavor_name", flavor.FlavorName)
+	d.Set("vcpus", flavor.VCPUS)
+	d.Set("ram", flavor.RAM)
+	d.Set("network", flavor.HardwareDescription.Network)
+
+	log.Println("[DEBUG] Finish LB flavor reading")
+
+	return diags
+}
@@ -0,0 +1,149 @@
+package edgecenter
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLBMember() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLBMemberRead,
+		Description: "Represent information about load balancer pool members together with their operating status. " +
+			"Can be used to check the health of the pool members, e.g. before switching traffic.",
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"project_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"region_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{"region_id", "region_name"},
+			},
+			"region_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{"region_id", "region_name"},
+			},
+			"pool_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The uuid for the load balancer pool.",
+			},
+			"member_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The uuid of the pool member. If specified, only this member is returned.",
+			},
+			"operating_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current operating status of the pool.",
+			},
+			"members": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of the pool members.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The uuid of the pool member.",
+						},
+						"address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address of the pool member.",
+						},
+						"protocol_port": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The port on which the member listens for requests.",
+						},
+						"weight": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The weight of the pool member.",
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The uuid of the subnet in which the pool member is located.",
+						},
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The uuid of the instance associated with the pool member.",
+						},
+						"operating_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The current operating status of the pool member, e.g. ONLINE, ERROR, NO_MONITOR.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLBMemberRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start LBMember reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	memberID := d.Get("member_id").(string)
+
+	pool, _, err := clientV2.Loadbalancers.PoolGet(ctx, poolID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	members := make([]map[string]interface{}, 0, len(pool.Members))
+	for _, pm := range pool.Members {
+		if memberID != "" && pm.ID != memberID {
+			continue
+		}
+		members = append(members, map[string]interface{}{
+			"id":               pm.ID,
+			"address":          lbMemberAddress(pm.Address),
+			"protocol_port":    pm.ProtocolPort,
+			"weight":           pm.Weight,
+			"subnet_id":        pm.SubnetID,
+			"instance_id":      pm.InstanceID,
+			"operating_status": string(pm.OperatingStatus),
+		})
+	}
+
+	if memberID != "" && len(members) == 0 {
+		return diag.Errorf("lb member with id %s not found in pool %s", memberID, poolID)
+	}
+
+	d.SetId(pool.ID)
+	d.Set("operating_status", string(pool.OperatingStatus))
+	if err := d.Set("members", members); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Println("[DEBUG] Finish LBMember reading")
+
+	return nil
+}
@@ -150,6 +150,97 @@ func dataSourceLBPool() *schema.Resource {
 					},
 				},
 			},
+			"operating_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The operating status of the pool, e.g. ONLINE or DEGRADED.",
+			},
+			"provisioning_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The provisioning status of the pool.",
+			},
+			"members": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The backend members of the pool.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protocol_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"admin_state_up": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"operating_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"member_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of backend members in the pool.",
+			},
+			"online_member_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of backend members in the ONLINE operating status.",
+			},
+			"loadbalancer_stats": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "The traffic statistics of the load balancer of the pool. " +
+					"The API collects the statistics for the whole load balancer, the statistics of the pool alone are not available.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active_connections": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_connections": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bytes_in": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bytes_out": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"request_errors": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -239,6 +330,49 @@ func dataSourceLBPoolRead(ctx context.Context, d *schema.ResourceData, m interfa
 		}
 	}
 
+	d.Set("operating_status", lb.OperatingStatus)
+	d.Set("provisioning_status", lb.ProvisioningStatus)
+
+	members := make([]interface{}, 0, len(lb.Members))
+	var onlineMembers int
+	for _, member := range lb.Members {
+		if member.OperatingStatus == edgecloudV2.OperatingStatusOnline {
+			onlineMembers++
+		}
+		members = append(members, map[string]interface{}{
+			"id":               member.ID,
+			"address":          lbMemberAddress(member.Address),
+			"protocol_port":    member.ProtocolPort,
+			"weight":           member.Weight,
+			"subnet_id":        member.SubnetID,
+			"instance_id":      member.InstanceID,
+			"admin_state_up":   member.AdminStateUP,
+			"operating_status": member.OperatingStatus,
+		})
+	}
+	if err := d.Set("members", members); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("member_count", len(lb.Members))
+	d.Set("online_member_count", onlineMembers)
+
+	if len(lb.Loadbalancers) > 0 {
+		loadbalancer, err := getLoadbalancerWithStats(ctx, clientV2, lb.Loadbalancers[0].ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		stats := map[string]interface{}{
+			"active_connections": loadbalancer.Stats.ActiveConnections,
+			"total_connections":  loadbalancer.Stats.TotalConnections,
+			"bytes_in":           loadbalancer.Stats.BytesIn,
+			"bytes_out":          loadbalancer.Stats.BytesOut,
+			"request_errors":     loadbalancer.Stats.RequestErrors,
+		}
+		if err := d.Set("loadbalancer_stats", []interface{}{stats}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	d.Set("project_id", d.Get("project_id").(int))
 	d.Set("region_id", d.Get("region_id").(int))
 
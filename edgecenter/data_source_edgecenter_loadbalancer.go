@@ -150,16 +150,7 @@ func dataSourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
-	var found bool
-	var lb edgecloudV2.Loadbalancer
-	for _, l := range lbs {
-		if l.Name == name {
-			lb = l
-			found = true
-			break
-		}
-	}
-
+	lb, found := findByPrefixedName(m, name, lbs, func(lb edgecloudV2.Loadbalancer) string { return lb.Name })
 	if !found {
 		return diag.Errorf("load balancer with name %s not found", name)
 	}
@@ -167,7 +158,7 @@ func dataSourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, m i
 	d.SetId(lb.ID)
 	d.Set("project_id", lb.ProjectID)
 	d.Set("region_id", lb.RegionID)
-	d.Set("name", lb.Name)
+	d.Set("name", name)
 	d.Set("vip_address", lb.VipAddress.String())
 	d.Set("vip_port_id", lb.VipPortID)
 
@@ -62,6 +62,11 @@ func dataSourceLoadBalancerV2() *schema.Resource {
 				Computed:    true,
 				Description: "Load balancer IP address",
 			},
+			"vip_ipv6_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Load balancer IP address if it is IPv6, otherwise empty.",
+			},
 			"vip_port_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -126,16 +131,7 @@ func dataSourceLoadBalancerV2Read(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	var found bool
-	var lb edgecloudV2.Loadbalancer
-	for _, l := range lbs {
-		if l.Name == name {
-			lb = l
-			found = true
-			break
-		}
-	}
-
+	lb, found := findByPrefixedName(m, name, lbs, func(lb edgecloudV2.Loadbalancer) string { return lb.Name })
 	if !found {
 		return diag.Errorf("load balancer with name %s not found", name)
 	}
@@ -143,8 +139,9 @@ func dataSourceLoadBalancerV2Read(ctx context.Context, d *schema.ResourceData, m
 	d.SetId(lb.ID)
 	d.Set("project_id", lb.ProjectID)
 	d.Set("region_id", lb.RegionID)
-	d.Set("name", lb.Name)
+	d.Set("name", name)
 	d.Set("vip_address", lb.VipAddress.String())
+	d.Set("vip_ipv6_address", lbVipIPv6Address(lb.VipAddress))
 	d.Set("vip_port_id", lb.VipPortID)
 
 	metadataList, _, err := clientV2.Loadbalancers.MetadataList(ctx, lb.ID)
@@ -222,7 +222,13 @@ func dataSourceNetworkRead(ctx context.Context, d *schema.ResourceData, m interf
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		network, found := findNetworkByName(name, nets)
+		var network edgecloudV2.Network
+		var found bool
+		for _, networkName := range prefixedNames(m, name) {
+			if network, found = findNetworkByName(networkName, nets); found {
+				break
+			}
+		}
 		if !found {
 			return diag.Errorf("network with name %s not found. you can try to set 'shared_with_subnets' parameter", name)
 		}
@@ -236,7 +242,13 @@ func dataSourceNetworkRead(ctx context.Context, d *schema.ResourceData, m interf
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		sharedNetwork, found := findSharedNetworkByName(name, nets)
+		var sharedNetwork edgecloudV2.NetworkSubnetwork
+		var found bool
+		for _, networkName := range prefixedNames(m, name) {
+			if sharedNetwork, found = findSharedNetworkByName(networkName, nets); found {
+				break
+			}
+		}
 		if !found {
 			return diag.Errorf("shared network with name %s not found", name)
 		}
@@ -248,7 +260,7 @@ func dataSourceNetworkRead(ctx context.Context, d *schema.ResourceData, m interf
 	}
 
 	d.SetId(rawNetwork["id"].(string))
-	d.Set("name", rawNetwork["name"])
+	d.Set("name", name)
 	d.Set("mtu", rawNetwork["mtu"])
 	d.Set("type", rawNetwork["type"])
 	d.Set("region_id", rawNetwork["region_id"])
@@ -0,0 +1,235 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+	utilV2 "github.com/Edge-Center/edgecentercloud-go/v2/util"
+)
+
+const (
+	PortMacAddressField  = "mac_address"
+	PortIPAddressesField = "ip_addresses"
+)
+
+// portLookupFilters are the attributes a port can be looked up by, at least one of them must be specified.
+var portLookupFilters = []string{IPAddressField, PortMacAddressField, InstanceIDField}
+
+// portLookupMatch is an instance interface matching the filters of the port data source.
+type portLookupMatch struct {
+	InstanceID string
+	Iface      edgecloudV2.InstancePortInterface
+}
+
+func dataSourcePort() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePortRead,
+		Description: "Represent an instance port found by its fixed IP address, MAC address or instance. " +
+			"Exactly one port must match the specified attributes.",
+
+		Schema: map[string]*schema.Schema{
+			ProjectIDField: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{ProjectIDField, ProjectNameField},
+			},
+			ProjectNameField: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{ProjectIDField, ProjectNameField},
+			},
+			RegionIDField: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+			},
+			RegionNameField: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+			},
+			IPAddressField: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The fixed IP address of the port.",
+				ValidateFunc: validation.IsIPAddress,
+				AtLeastOneOf: portLookupFilters,
+			},
+			PortMacAddressField: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The MAC address of the port.",
+				ValidateFunc: validation.IsMACAddress,
+				AtLeastOneOf: portLookupFilters,
+			},
+			InstanceIDField: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The uuid of the instance (device) the port is attached to.",
+				ValidateFunc: validation.IsUUID,
+				AtLeastOneOf: portLookupFilters,
+			},
+			NetworkIDField: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The uuid of the network of the port. If specified, only the ports of this network are searched.",
+				ValidateFunc: validation.IsUUID,
+			},
+			PortIPAddressesField: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The fixed IP addresses of the port.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			PortSecurityDisabledField: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is the port_security feature disabled.",
+			},
+			SecurityGroupIDsField: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Set of the security groups IDs of the port.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourcePortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start port reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceIDs, err := portLookupInstanceIDs(ctx, clientV2, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var matches []portLookupMatch
+	for _, instanceID := range instanceIDs {
+		ifaces, _, err := clientV2.Instances.InterfaceList(ctx, instanceID)
+		if err != nil {
+			return diag.Errorf("cannot list interfaces of instance %s: %s", instanceID, err)
+		}
+		for _, iface := range ifaces {
+			if portLookupMatches(d, iface) {
+				matches = append(matches, portLookupMatch{InstanceID: instanceID, Iface: iface})
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return diag.Errorf("port not found")
+	case 1:
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, match := range matches {
+			ids = append(ids, match.Iface.PortID)
+		}
+		return diag.Errorf("multiple ports found: %s, specify more attributes", strings.Join(ids, ", "))
+	}
+
+	match := matches[0]
+	iface := match.Iface
+
+	ipAddresses := make([]string, 0, len(iface.IPAssignments))
+	for _, ip := range iface.IPAssignments {
+		ipAddresses = append(ipAddresses, ip.IPAddress.String())
+	}
+
+	d.SetId(iface.PortID)
+	d.Set(InstanceIDField, match.InstanceID)
+	d.Set(NetworkIDField, iface.NetworkID)
+	d.Set(PortMacAddressField, iface.MacAddress)
+	d.Set(PortIPAddressesField, ipAddresses)
+	d.Set(PortSecurityDisabledField, !iface.PortSecurityEnabled)
+
+	sgIDs := make([]interface{}, 0)
+	if iface.PortSecurityEnabled {
+		instancePort, err := utilV2.InstanceNetworkPortByID(ctx, clientV2, match.InstanceID, iface.PortID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, sg := range instancePort.SecurityGroups {
+			sgIDs = append(sgIDs, sg.ID)
+		}
+	}
+	d.Set(SecurityGroupIDsField, schema.NewSet(schema.HashString, sgIDs))
+
+	log.Println("[DEBUG] Finish port reading")
+
+	return nil
+}
+
+// portLookupInstanceIDs returns the instances whose interfaces are searched: the specified instance,
+// the instances with ports in the specified network or all instances of the project.
+func portLookupInstanceIDs(ctx context.Context, client *edgecloudV2.Client, d *schema.ResourceData) ([]string, error) {
+	if instanceID := d.Get(InstanceIDField).(string); instanceID != "" {
+		return []string{instanceID}, nil
+	}
+
+	var instanceIDs []string
+	if networkID := d.Get(NetworkIDField).(string); networkID != "" {
+		ports, _, err := client.Networks.PortList(ctx, networkID)
+		if err != nil {
+			return nil, fmt.Errorf("cannot list ports of network %s: %w", networkID, err)
+		}
+		seen := make(map[string]bool)
+		for _, port := range ports {
+			if port.InstanceID != "" && !seen[port.InstanceID] {
+				seen[port.InstanceID] = true
+				instanceIDs = append(instanceIDs, port.InstanceID)
+			}
+		}
+
+		return instanceIDs, nil
+	}
+
+	instances, _, err := client.Instances.List(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list instances: %w", err)
+	}
+	for _, instance := range instances {
+		instanceIDs = append(instanceIDs, instance.ID)
+	}
+
+	return instanceIDs, nil
+}
+
+// portLookupMatches checks that the interface has the IP address, MAC address and network specified in the data source.
+func portLookupMatches(d *schema.ResourceData, iface edgecloudV2.InstancePortInterface) bool {
+	if networkID := d.Get(NetworkIDField).(string); networkID != "" && iface.NetworkID != networkID {
+		return false
+	}
+	if mac := d.Get(PortMacAddressField).(string); mac != "" && !strings.EqualFold(iface.MacAddress, mac) {
+		return false
+	}
+	if ipAddress := d.Get(IPAddressField).(string); ipAddress != "" {
+		for _, ip := range iface.IPAssignments {
+			if ip.IPAddress.String() == ipAddress {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,269 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var providerSchemaFieldSchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Computed: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the field. Possible values are: bool, int, float, string, list, set, map.",
+			},
+			"required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"optional": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"computed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"force_new": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"deprecated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	},
+}
+
+func dataSourceProviderSchema() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceProviderSchemaRead,
+		Description: "Represent the list of resources and data sources supported by the provider together with their fields. " +
+			"Can be used to validate module coverage against provider capabilities.",
+		Schema: map[string]*schema.Schema{
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"importable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if the resource supports terraform import.",
+						},
+						"example": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Minimal configuration snippet with required fields of the resource.",
+						},
+						"import_example": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Import command snippet, empty if the resource is not importable.",
+						},
+						"fields": providerSchemaFieldSchema,
+						"force_new_fields": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Description: "Names of the fields which force replacement of the resource when changed, " +
+								"including the fields of nested blocks prefixed with the block name, e.g. 'block.field'.",
+							Elem: &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"data_sources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"example": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Minimal configuration snippet with required fields of the data source.",
+						},
+						"fields": providerSchemaFieldSchema,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceProviderSchemaRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Provider Schema reading")
+
+	p := Provider()
+
+	resources := make([]map[string]interface{}, 0, len(p.ResourcesMap))
+	for _, name := range sortedResourceNames(p.ResourcesMap) {
+		r := p.ResourcesMap[name]
+		importExample := ""
+		if r.Importer != nil {
+			importExample = fmt.Sprintf("terraform import %s.example <id>", name)
+		}
+		resources = append(resources, map[string]interface{}{
+			"name":             name,
+			"importable":       r.Importer != nil,
+			"example":          providerSchemaExample("resource", name, r.Schema),
+			"import_example":   importExample,
+			"fields":           providerSchemaFields(r.Schema),
+			"force_new_fields": providerSchemaForceNewFields(r.Schema, ""),
+		})
+	}
+
+	dataSources := make([]map[string]interface{}, 0, len(p.DataSourcesMap))
+	for _, name := range sortedResourceNames(p.DataSourcesMap) {
+		r := p.DataSourcesMap[name]
+		dataSources = append(dataSources, map[string]interface{}{
+			"name":    name,
+			"example": providerSchemaExample("data", name, r.Schema),
+			"fields":  providerSchemaFields(r.Schema),
+		})
+	}
+
+	if err := d.Set("resources", resources); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("data_sources", dataSources); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("edgecenter")
+
+	log.Println("[DEBUG] Finish Provider Schema reading")
+
+	return nil
+}
+
+func sortedResourceNames(m map[string]*schema.Resource) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func sortedSchemaNames(m map[string]*schema.Schema) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func providerSchemaFields(s map[string]*schema.Schema) []map[string]interface{} {
+	fields := make([]map[string]interface{}, 0, len(s))
+	for _, name := range sortedSchemaNames(s) {
+		f := s[name]
+		fields = append(fields, map[string]interface{}{
+			"name":       name,
+			"type":       providerSchemaTypeName(f.Type),
+			"required":   f.Required,
+			"optional":   f.Optional,
+			"computed":   f.Computed,
+			"force_new":  f.ForceNew,
+			"deprecated": f.Deprecated != "",
+		})
+	}
+
+	return fields
+}
+
+// providerSchemaForceNewFields returns the sorted paths of the fields which force replacement,
+// the fields of nested blocks are prefixed with the block name.
+func providerSchemaForceNewFields(s map[string]*schema.Schema, prefix string) []string {
+	fields := make([]string, 0)
+	for _, name := range sortedSchemaNames(s) {
+		f := s[name]
+		if f.ForceNew {
+			fields = append(fields, prefix+name)
+		}
+		if elem, ok := f.Elem.(*schema.Resource); ok {
+			fields = append(fields, providerSchemaForceNewFields(elem.Schema, prefix+name+".")...)
+		}
+	}
+
+	return fields
+}
+
+func providerSchemaTypeName(t schema.ValueType) string {
+	switch t {
+	case schema.TypeBool:
+		return "bool"
+	case schema.TypeInt:
+		return "int"
+	case schema.TypeFloat:
+		return "float"
+	case schema.TypeString:
+		return "string"
+	case schema.TypeList:
+		return "list"
+	case schema.TypeSet:
+		return "set"
+	case schema.TypeMap:
+		return "map"
+	default:
+		return "invalid"
+	}
+}
+
+// providerSchemaExample renders a configuration block with placeholders for the required fields.
+func providerSchemaExample(kind, name string, s map[string]*schema.Schema) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %q \"example\" {\n", kind, name)
+	for _, field := range sortedSchemaNames(s) {
+		f := s[field]
+		if !f.Required {
+			continue
+		}
+		if _, ok := f.Elem.(*schema.Resource); ok {
+			fmt.Fprintf(&b, "  %s {\n  }\n", field)
+			continue
+		}
+		fmt.Fprintf(&b, "  %s = %s\n", field, providerSchemaPlaceholder(f.Type))
+	}
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+func providerSchemaPlaceholder(t schema.ValueType) string {
+	switch t {
+	case schema.TypeBool:
+		return "false"
+	case schema.TypeInt, schema.TypeFloat:
+		return "0"
+	case schema.TypeList, schema.TypeSet:
+		return "[]"
+	case schema.TypeMap:
+		return "{}"
+	default:
+		return `""`
+	}
+}
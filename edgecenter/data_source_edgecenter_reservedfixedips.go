@@ -0,0 +1,183 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	reservedFixedIPTypeInternal = "internal"
+	reservedFixedIPTypeExternal = "external"
+)
+
+func dataSourceReservedFixedIPs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceReservedFixedIPsRead,
+		Description: "Represent the list of reserved ips. Can be used to find reserved ips which may be reused before reserving new ones.",
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"project_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"region_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{"region_id", "region_name"},
+			},
+			"region_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{"region_id", "region_name"},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  fmt.Sprintf("Filter by the type of the reserved fixed IPs. Available values are '%s' and '%s'.", reservedFixedIPTypeInternal, reservedFixedIPTypeExternal),
+				ValidateFunc: validation.StringInSlice([]string{reservedFixedIPTypeInternal, reservedFixedIPTypeExternal}, false),
+			},
+			"available_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true, only the reserved fixed IPs which are not attached to any resource are returned.",
+			},
+			"vip_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true, only the reserved fixed IPs which are used as a Virtual IP (VIP) are returned.",
+			},
+			"ip_address_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter by the beginning of the reserved fixed IP address, e.g. '192.168.10.'.",
+			},
+			"reserved_fixed_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of the reserved fixed IPs.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the port_id underlying the reserved fixed IP.",
+						},
+						"fixed_ip_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address that is associated with the reserved IP.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the reserved fixed IP.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The current status of the reserved fixed IP.",
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the subnet from which the fixed IP is reserved.",
+						},
+						"network_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the network to which the reserved fixed IP is associated.",
+						},
+						"is_vip": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Flag to determine if the reserved fixed IP is treated as a Virtual IP (VIP).",
+						},
+						"is_external": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Flag to determine if the reserved fixed IP belongs to an external network.",
+						},
+						"reservation": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "The status of the reserved fixed IP with the type of the resource and the ID it is attached to.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceReservedFixedIPsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start ReservedFixedIPs reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := &edgecloudV2.ReservedFixedIPListOptions{
+		AvailableOnly:  d.Get("available_only").(bool),
+		VIPOnly:        d.Get("vip_only").(bool),
+		SearchPrefixIP: d.Get("ip_address_prefix").(string),
+	}
+	switch d.Get("type").(string) {
+	case reservedFixedIPTypeInternal:
+		opts.InternalOnly = true
+	case reservedFixedIPTypeExternal:
+		opts.ExternalOnly = true
+	}
+
+	ips, _, err := clientV2.ReservedFixedIP.List(ctx, opts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	reservedFixedIPs := make([]map[string]interface{}, 0, len(ips))
+	for _, ip := range ips {
+		reservedFixedIPs = append(reservedFixedIPs, map[string]interface{}{
+			"port_id":          ip.PortID,
+			"fixed_ip_address": ip.FixedIPAddress.String(),
+			"name":             ip.Name,
+			"status":           ip.Status,
+			"subnet_id":        ip.SubnetID,
+			"network_id":       ip.NetworkID,
+			"is_vip":           ip.IsVIP,
+			"is_external":      ip.IsExternal,
+			"reservation": map[string]string{
+				"status":        ip.Reservation.Status,
+				"resource_type": ip.Reservation.ResourceType,
+				"resource_id":   ip.Reservation.ResourceID,
+			},
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d:%d", clientV2.Project, clientV2.Region))
+	if err := d.Set("reserved_fixed_ips", reservedFixedIPs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Println("[DEBUG] Finish ReservedFixedIPs reading")
+
+	return nil
+}
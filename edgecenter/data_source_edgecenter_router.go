@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
 )
@@ -13,6 +14,7 @@ import (
 func dataSourceRouter() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceRouterRead,
+		Description: "Represent the router found by its name or by the network it is connected to.",
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeInt,
@@ -39,99 +41,27 @@ func dataSourceRouter() *schema.Resource {
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the load router.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The name of the router.",
+				AtLeastOneOf: []string{"name", "network_id"},
+			},
+			"network_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The ID of the network, which the router is connected to by an interface or the external gateway.",
+				ValidateFunc: validation.IsUUID,
+				AtLeastOneOf: []string{"name", "network_id"},
 			},
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "The current status of the router resource.",
 			},
-			"external_gateway_info": {
-				Type:        schema.TypeList,
-				Computed:    true,
-				Description: "Information related to the external gateway.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"enable_snat": {
-							Type:     schema.TypeBool,
-							Computed: true,
-						},
-						"network_id": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"external_fixed_ips": {
-							Type:     schema.TypeList,
-							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"ip_address": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"subnet_id": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-			"interfaces": {
-				Type:        schema.TypeList,
-				Computed:    true,
-				Description: "Set of interfaces associated with the router.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"port_id": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"network_id": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"mac_address": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"type": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"ip_address": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"subnet_id": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-					},
-				},
-			},
-			"routes": {
-				Type:        schema.TypeList,
-				Computed:    true,
-				Description: "List of static routes to be applied to the router.",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"destination": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"nexthop": {
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "IPv4 address to forward traffic to if it's destination IP matches 'destination' CIDR",
-						},
-					},
-				},
-			},
+			"external_gateway_info": routerExternalGatewayInfoComputedSchema(),
+			"interfaces":            routerInterfacesComputedSchema(),
+			"routes":                routerRoutesComputedSchema(),
 		},
 	}
 }
@@ -146,6 +76,7 @@ func dataSourceRouterRead(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 
 	name := d.Get("name").(string)
+	networkID := d.Get("network_id").(string)
 
 	rs, _, err := clientV2.Routers.List(ctx)
 	if err != nil {
@@ -155,7 +86,7 @@ func dataSourceRouterRead(ctx context.Context, d *schema.ResourceData, m interfa
 	var found bool
 	var router edgecloudV2.Router
 	for _, r := range rs {
-		if r.Name == name {
+		if (name == "" || r.Name == name) && (networkID == "" || routerConnectedToNetwork(r, networkID)) {
 			router = r
 			found = true
 			break
@@ -163,32 +94,57 @@ func dataSourceRouterRead(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 
 	if !found {
-		return diag.Errorf("router with name %s not found", name)
+		return diag.Errorf("router with name %q and network %q not found", name, networkID)
 	}
 
 	d.SetId(router.ID)
 	d.Set("name", router.Name)
-	d.Set("status", router.Status)
 	d.Set("region_id", router.RegionID)
 	d.Set("project_id", router.ProjectID)
+	for key, value := range flattenRouterComputedAttrs(router) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Println("[DEBUG] Finish router reading")
+
+	return diags
+}
+
+// routerConnectedToNetwork checks whether the router has an interface in the network or its external gateway is in it.
+func routerConnectedToNetwork(router edgecloudV2.Router, networkID string) bool {
+	if router.ExternalGatewayInfo.NetworkID == networkID {
+		return true
+	}
+	for _, iface := range router.Interfaces {
+		if iface.NetworkID == networkID {
+			return true
+		}
+	}
+
+	return false
+}
 
+// flattenRouterComputedAttrs returns the status, external gateway, interfaces and routes of the router
+// for the router data sources.
+func flattenRouterComputedAttrs(router edgecloudV2.Router) map[string]interface{} {
+	egilst := make([]map[string]interface{}, 0, 1)
 	if len(router.ExternalGatewayInfo.ExternalFixedIPs) > 0 {
-		egi := make(map[string]interface{}, 4)
-		egilst := make([]map[string]interface{}, 1)
+		egi := make(map[string]interface{}, 3)
 		egi["enable_snat"] = router.ExternalGatewayInfo.EnableSnat
 		egi["network_id"] = router.ExternalGatewayInfo.NetworkID
 
 		efip := make([]map[string]string, len(router.ExternalGatewayInfo.ExternalFixedIPs))
 		for i, fip := range router.ExternalGatewayInfo.ExternalFixedIPs {
-			tmpfip := make(map[string]string, 1)
+			tmpfip := make(map[string]string, 2)
 			tmpfip["ip_address"] = fip.IPAddress
 			tmpfip["subnet_id"] = fip.SubnetID
 			efip[i] = tmpfip
 		}
 		egi["external_fixed_ips"] = efip
 
-		egilst[0] = egi
-		d.Set("external_gateway_info", egilst)
+		egilst = append(egilst, egi)
 	}
 
 	ifs := make([]map[string]interface{}, 0, len(router.Interfaces))
@@ -204,7 +160,6 @@ func dataSourceRouterRead(ctx context.Context, d *schema.ResourceData, m interfa
 			ifs = append(ifs, smap)
 		}
 	}
-	d.Set("interfaces", ifs)
 
 	rss := make([]map[string]string, len(router.Routes))
 	for i, r := range router.Routes {
@@ -213,9 +168,104 @@ func dataSourceRouterRead(ctx context.Context, d *schema.ResourceData, m interfa
 		rmap["nexthop"] = r.NextHop.String()
 		rss[i] = rmap
 	}
-	d.Set("routes", rss)
 
-	log.Println("[DEBUG] Finish router reading")
+	return map[string]interface{}{
+		"status":                router.Status,
+		"external_gateway_info": egilst,
+		"interfaces":            ifs,
+		"routes":                rss,
+	}
+}
 
-	return diags
+func routerExternalGatewayInfoComputedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Information related to the external gateway.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enable_snat": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"network_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"external_fixed_ips": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"ip_address": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"subnet_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func routerInterfacesComputedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Set of interfaces associated with the router.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"port_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"network_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"mac_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"ip_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"subnet_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func routerRoutesComputedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "List of static routes to be applied to the router.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"nexthop": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "IPv4 address to forward traffic to if it's destination IP matches 'destination' CIDR",
+				},
+			},
+		},
+	}
 }
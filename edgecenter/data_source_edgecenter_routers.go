@@ -0,0 +1,136 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceRouters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRoutersRead,
+		Description: "Represent the list of routers matching the filters with their interfaces and routes. " +
+			"Can be used to look up the existing routers of the networks before managing them.",
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"project_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"region_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{"region_id", "region_name"},
+			},
+			"region_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{"region_id", "region_name"},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Filter by the regular expression, which the router name must match, e.g. '^prod-'.",
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"network_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Filter by the ID of the network, which the routers are connected to by an interface or the external gateway.",
+				ValidateFunc: validation.IsUUID,
+			},
+			"routers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of the routers sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the router.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the router.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The current status of the router resource.",
+						},
+						"external_gateway_info": routerExternalGatewayInfoComputedSchema(),
+						"interfaces":            routerInterfacesComputedSchema(),
+						"routes":                routerRoutesComputedSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRoutersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start Routers reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rs, _, err := clientV2.Routers.List(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if pattern := d.Get("name_regex").(string); pattern != "" {
+		nameRegex = regexp.MustCompile(pattern)
+	}
+	networkID := d.Get("network_id").(string)
+
+	sort.SliceStable(rs, func(i, j int) bool {
+		if rs[i].Name == rs[j].Name {
+			return rs[i].ID < rs[j].ID
+		}
+		return rs[i].Name < rs[j].Name
+	})
+
+	routers := make([]map[string]interface{}, 0, len(rs))
+	for _, router := range rs {
+		if nameRegex != nil && !nameRegex.MatchString(router.Name) {
+			continue
+		}
+		if networkID != "" && !routerConnectedToNetwork(router, networkID) {
+			continue
+		}
+		r := flattenRouterComputedAttrs(router)
+		r["id"] = router.ID
+		r["name"] = router.Name
+		routers = append(routers, r)
+	}
+
+	d.SetId(fmt.Sprintf("%d:%d", clientV2.Project, clientV2.Region))
+	if err := d.Set("routers", routers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Println("[DEBUG] Finish Routers reading")
+
+	return nil
+}
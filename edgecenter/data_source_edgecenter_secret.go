@@ -3,9 +3,12 @@ package edgecenter
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
 )
 
 func dataSourceSecret() *schema.Resource {
@@ -42,6 +45,13 @@ func dataSourceSecret() *schema.Resource {
 				Required:    true,
 				Description: "The name of the secret.",
 			},
+			"must_not_expire_within": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDuration,
+				Description: "If set, the newest of the secrets with the given name which does not expire within the given duration, " +
+					"e.g. '720h', is selected. Secrets without expiration never expire. Useful to reference a certificate after its rotation.",
+			},
 			"algorithm": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -101,30 +111,40 @@ func dataSourceSecretRead(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.Errorf("cannot get secrets. Error: %s", err.Error())
 	}
 
-	var found bool
 	name := d.Get("name").(string)
-	for _, secret := range allSecrets {
-		if name == secret.Name {
-			d.SetId(secret.ID)
-			d.Set("name", name)
-			d.Set("algorithm", secret.Algorithm)
-			d.Set("bit_length", secret.BitLength)
-			d.Set("mode", secret.Mode)
-			d.Set("status", secret.Status)
-			d.Set("expiration", secret.Expiration)
-			d.Set("created", secret.Created)
-
-			if err := d.Set("content_types", secret.ContentTypes); err != nil {
-				return diag.FromErr(err)
+	var secret *edgecloudV2.Secret
+	if rawDuration, ok := d.GetOk("must_not_expire_within"); ok {
+		duration, _ := time.ParseDuration(rawDuration.(string))
+		secret, err = latestValidSecret(allSecrets, name, time.Now().UTC().Add(duration))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if secret == nil {
+			return diag.Errorf("secret with name %s which does not expire within %s does not exist", name, rawDuration.(string))
+		}
+	} else {
+		for i := range allSecrets {
+			if name == allSecrets[i].Name {
+				secret = &allSecrets[i]
+				break
 			}
-			found = true
-
-			break
+		}
+		if secret == nil {
+			return diag.Errorf("secret with name %s does not exit", name)
 		}
 	}
 
-	if !found {
-		return diag.Errorf("secret with name %s does not exit", name)
+	d.SetId(secret.ID)
+	d.Set("name", name)
+	d.Set("algorithm", secret.Algorithm)
+	d.Set("bit_length", secret.BitLength)
+	d.Set("mode", secret.Mode)
+	d.Set("status", secret.Status)
+	d.Set("expiration", secret.Expiration)
+	d.Set("created", secret.Created)
+
+	if err := d.Set("content_types", secret.ContentTypes); err != nil {
+		return diag.FromErr(err)
 	}
 
 	log.Println("[DEBUG] Finish secret reading")
@@ -0,0 +1,161 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceSecrets() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSecretsRead,
+		Description: "Represent the list of secrets. Can be filtered by the expiration date, e.g. to plan the rotation of certificates.",
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"project_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"region_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{"region_id", "region_name"},
+			},
+			"region_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{"region_id", "region_name"},
+			},
+			"expiring_within_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description: "If set, only the secrets which expire within the given number of days are returned, " +
+					"including the already expired ones. Secrets without expiration are skipped.",
+			},
+			"secrets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of the secrets.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The uuid of the secret.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the secret.",
+						},
+						"algorithm": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The encryption algorithm used for the secret.",
+						},
+						"bit_length": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The bit length of the encryption algorithm.",
+						},
+						"mode": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The mode of the encryption algorithm.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The current status of the secret.",
+						},
+						"secret_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the secret.",
+						},
+						"expiration": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Datetime when the secret will expire. The format is 2025-12-28T19:14:44",
+						},
+						"created": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Datetime when the secret was created. The format is 2025-12-28T19:14:44.180394",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSecretsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start secrets reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	allSecrets, _, err := clientV2.Secrets.List(ctx)
+	if err != nil {
+		return diag.Errorf("cannot get secrets. Error: %s", err.Error())
+	}
+
+	days, filterByExpiration := d.GetOk("expiring_within_days")
+	deadline := time.Now().UTC().AddDate(0, 0, days.(int))
+
+	secrets := make([]map[string]interface{}, 0, len(allSecrets))
+	for _, secret := range allSecrets {
+		var expiration string
+		if secret.Expiration != "" {
+			expTime, err := time.Parse(RFC3339WithTimeZone, secret.Expiration)
+			if err != nil {
+				return diag.Errorf("cannot parse expiration of secret %s. Error: %s", secret.ID, err.Error())
+			}
+			if filterByExpiration && expTime.After(deadline) {
+				continue
+			}
+			expiration = expTime.Format(RFC3339NoZ)
+		} else if filterByExpiration {
+			continue
+		}
+
+		secrets = append(secrets, map[string]interface{}{
+			"id":          secret.ID,
+			"name":        secret.Name,
+			"algorithm":   secret.Algorithm,
+			"bit_length":  secret.BitLength,
+			"mode":        secret.Mode,
+			"status":      secret.Status,
+			"secret_type": secret.SecretType,
+			"expiration":  expiration,
+			"created":     secret.Created,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d:%d", clientV2.Project, clientV2.Region))
+	if err := d.Set("secrets", secrets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Println("[DEBUG] Finish secrets reading")
+
+	return nil
+}
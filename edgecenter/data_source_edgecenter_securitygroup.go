@@ -87,6 +87,12 @@ func dataSourceSecurityGroup() *schema.Resource {
 					},
 				},
 			},
+			"rules_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "A canonical JSON representation of the security group rules, sorted and without server-generated fields " +
+					"(id, created_at, updated_at). Can be used for policy checks, e.g. with OPA or Sentinel.",
+			},
 			"security_group_rules": {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -177,16 +183,7 @@ func dataSourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	var found bool
-	var sg edgecloudV2.SecurityGroup
-	for _, s := range sgs {
-		if s.Name == name {
-			sg = s
-			found = true
-			break
-		}
-	}
-
+	sg, found := findByPrefixedName(m, name, sgs, func(sg edgecloudV2.SecurityGroup) string { return sg.Name })
 	if !found {
 		return diag.Errorf("security group with name %s not found", name)
 	}
@@ -194,7 +191,7 @@ func dataSourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, m
 	d.SetId(sg.ID)
 	d.Set("project_id", sg.ProjectID)
 	d.Set("region_id", sg.RegionID)
-	d.Set("name", sg.Name)
+	d.Set("name", name)
 	d.Set("description", sg.Description)
 
 	metadataReadOnly := make([]map[string]interface{}, 0, len(sg.Metadata))
@@ -257,6 +254,12 @@ func dataSourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	rulesJSON, err := securityGroupRulesJSON(newSgRules)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("rules_json", rulesJSON)
+
 	log.Println("[DEBUG] Finish SecurityGroup reading")
 
 	return diags
@@ -2,7 +2,10 @@ package edgecenter
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -68,7 +71,26 @@ func dataSourceSnapshot() *schema.Resource {
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "The status of the snapshot.",
+				Optional:    true,
+				Description: "The status of the snapshot. If specified, only the snapshots with this status are looked up, e.g. 'available'.",
+			},
+			"metadata_k": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filtration query opts (only key).",
+			},
+			"metadata_kv": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: `Filtration query opts, for example, {env = "prod"}`,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"most_recent": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If more than one snapshot matches the filters, use the most recently created one.",
 			},
 			"size": {
 				Type:        schema.TypeInt,
@@ -128,8 +150,11 @@ func dataSourceSnapshotRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	default:
 		name := d.Get("name").(string)
-		snapshotsOpts := &edgecloudV2.SnapshotListOptions{VolumeID: volumeID}
+		status := d.Get("status").(string)
+		metadataK := d.Get("metadata_k").(string)
+		metadataKV := d.Get("metadata_kv").(map[string]interface{})
 
+		snapshotsOpts := &edgecloudV2.SnapshotListOptions{VolumeID: volumeID}
 		allSnapshots, _, err := clientV2.Snapshots.List(ctx, snapshotsOpts)
 		if err != nil {
 			return diag.Errorf("cannot get snapshots. Error: %s", err.Error())
@@ -138,17 +163,30 @@ func dataSourceSnapshotRead(ctx context.Context, d *schema.ResourceData, m inter
 		var foundSnapshots []*edgecloudV2.Snapshot
 		for _, s := range allSnapshots {
 			snapshot := s
-			if name == snapshot.Name {
-				foundSnapshots = append(foundSnapshots, &snapshot)
+			if name != "" && name != snapshot.Name {
+				continue
 			}
+			if status != "" && status != snapshot.Status {
+				continue
+			}
+			if !snapshotMetadataMatches(snapshot.Metadata, metadataK, metadataKV) {
+				continue
+			}
+			foundSnapshots = append(foundSnapshots, &snapshot)
 		}
 
 		if len(foundSnapshots) == 0 {
-			return diag.Errorf("snapshot with name %s does not exist", name)
+			return diag.Errorf("snapshot%s does not exist", snapshotFilterDescription(name, volumeID, status, metadataK, metadataKV))
 		} else if len(foundSnapshots) > 1 {
-			return diag.Errorf("multiple snapshots found with name %s. Use snapshot_id instead of name.", name)
+			if !d.Get("most_recent").(bool) {
+				return diag.Errorf("multiple snapshots found%s. Use snapshot_id, add filters or set most_recent.",
+					snapshotFilterDescription(name, volumeID, status, metadataK, metadataKV))
+			}
+			// created_at values have the same format, so they can be compared as strings
+			sort.Slice(foundSnapshots, func(i, j int) bool {
+				return foundSnapshots[i].CreatedAt > foundSnapshots[j].CreatedAt
+			})
 		}
-
 		snapshot = foundSnapshots[0]
 	}
 
@@ -159,6 +197,36 @@ func dataSourceSnapshotRead(ctx context.Context, d *schema.ResourceData, m inter
 	return diags
 }
 
+// snapshotFilterDescription describes the filters the snapshot is looked up by in the errors of the lookup.
+func snapshotFilterDescription(name, volumeID, status, metadataK string, metadataKV map[string]interface{}) string {
+	var filters []string
+	if name != "" {
+		filters = append(filters, "name "+name)
+	}
+	if volumeID != "" {
+		filters = append(filters, "volume_id "+volumeID)
+	}
+	if status != "" {
+		filters = append(filters, "status "+status)
+	}
+	if metadataK != "" {
+		filters = append(filters, "metadata key "+metadataK)
+	}
+	if len(metadataKV) > 0 {
+		pairs := make([]string, 0, len(metadataKV))
+		for k, v := range metadataKV {
+			pairs = append(pairs, fmt.Sprintf("%s=%v", k, v))
+		}
+		sort.Strings(pairs)
+		filters = append(filters, "metadata "+strings.Join(pairs, ","))
+	}
+	if len(filters) == 0 {
+		return ""
+	}
+
+	return " with " + strings.Join(filters, ", ")
+}
+
 func setSnapshotData(d *schema.ResourceData, snapshot *edgecloudV2.Snapshot) {
 	d.SetId(snapshot.ID)
 	d.Set("name", snapshot.Name)
@@ -172,3 +240,19 @@ func setSnapshotData(d *schema.ResourceData, snapshot *edgecloudV2.Snapshot) {
 	d.Set("snapshot_id", snapshot.ID)
 	d.Set("metadata", snapshot.Metadata)
 }
+
+// snapshotMetadataMatches checks whether the snapshot metadata has the key and all the key-value pairs.
+func snapshotMetadataMatches(metadata edgecloudV2.Metadata, key string, kv map[string]interface{}) bool {
+	if key != "" {
+		if _, ok := metadata[key]; !ok {
+			return false
+		}
+	}
+	for k, v := range kv {
+		if metadata[k] != v.(string) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,33 @@
+package edgecenter
+
+import "testing"
+
+func TestSnapshotFilterDescription(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		snapshot   string
+		volumeID   string
+		status     string
+		metadataK  string
+		metadataKV map[string]interface{}
+		want       string
+	}{
+		{name: "by name", snapshot: "backup", want: " with name backup"},
+		{name: "by filters without name", status: "available", metadataK: "env", want: " with status available, metadata key env"},
+		{
+			name:       "by volume and metadata",
+			volumeID:   "volume",
+			metadataKV: map[string]interface{}{"team": "db", "env": "prod"},
+			want:       " with volume_id volume, metadata env=prod,team=db",
+		},
+		{name: "without filters", want: ""},
+	}
+	for _, tt := range tests {
+		got := snapshotFilterDescription(tt.snapshot, tt.volumeID, tt.status, tt.metadataK, tt.metadataKV)
+		if got != tt.want {
+			t.Errorf("%s: snapshotFilterDescription() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
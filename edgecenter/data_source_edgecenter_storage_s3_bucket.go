@@ -30,6 +30,11 @@ func dataSourceStorageS3Bucket() *schema.Resource {
 				},
 				Description: "A name of storage bucket resource.",
 			},
+			StorageSchemaGenerateHTTPEndpoint: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A http entry point of the bucket, can be used as a CDN origin.",
+			},
 		},
 		ReadContext: resourceStorageS3BucketRead,
 		Description: "Represent storage s3 bucket resource.",
@@ -0,0 +1,200 @@
+package edgecenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Edge-Center/edgecenter-storage-sdk-go/swagger/client/storages"
+	"github.com/Edge-Center/edgecenter-storage-sdk-go/swagger/models"
+)
+
+const (
+	StorageSFTPSchemaHost    = "host"
+	StorageSFTPSchemaPort    = "port"
+	StorageSFTPSchemaLogin   = "login"
+	StorageSFTPSchemaExpires = "expires"
+
+	storageTypeSFTP = "sftp"
+	// storageSFTPDefaultPort is the port of the sftp entry point of the storage address without a port.
+	storageSFTPDefaultPort = 2200
+)
+
+func dataSourceStorageSFTP() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			StorageSchemaID: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				AtLeastOneOf: []string{
+					StorageSchemaID,
+					StorageSchemaName,
+				},
+				Description: "An id of the storage resource.",
+			},
+			StorageSchemaClientID: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "An client id of the storage resource.",
+			},
+			StorageSchemaName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+					storageName := i.(string)
+					if !regexp.MustCompile(`^[\w\-]+$`).MatchString(storageName) || len(storageName) > 255 {
+						return diag.Errorf("storage name can't be empty and can have only letters, numbers, dashes and underscores, it also should be less than 256 symbols")
+					}
+					return nil
+				},
+				AtLeastOneOf: []string{
+					StorageSchemaID,
+					StorageSchemaName,
+				},
+				Description: "A name of the storage resource.",
+			},
+			StorageSchemaLocation: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A location of the storage resource.",
+			},
+			StorageSFTPSchemaHost: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A host of the sftp entry point.",
+			},
+			StorageSFTPSchemaPort: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: fmt.Sprintf("A port of the sftp entry point, taken from the storage address or %d by default.", storageSFTPDefaultPort),
+			},
+			StorageSFTPSchemaLogin: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A login to access the storage over sftp.",
+			},
+			StorageSFTPSchemaExpires: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An expiration time of the storage files, empty if the files never expire.",
+			},
+		},
+		ReadContext: dataSourceStorageSFTPRead,
+		Description: "Represent sftp storage resource. https://storage.edgecenter.ru/storage/list",
+	}
+}
+
+func dataSourceStorageSFTPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resourceID := storageResourceID(d)
+	log.Printf("[DEBUG] Start SFTP Storage reading (id=%s)\n", resourceID)
+	defer log.Println("[DEBUG] Finish SFTP Storage reading")
+
+	config := m.(*Config)
+	client := config.StorageClient
+
+	storageType := storageTypeSFTP
+	opts := []func(opt *storages.StorageListHTTPV2Params){
+		func(opt *storages.StorageListHTTPV2Params) { opt.Context = ctx },
+		func(opt *storages.StorageListHTTPV2Params) { opt.ShowDeleted = new(bool) },
+		func(opt *storages.StorageListHTTPV2Params) { opt.Type = &storageType },
+	}
+	if resourceID != "" {
+		opts = append(opts, func(opt *storages.StorageListHTTPV2Params) { opt.ID = &resourceID })
+	}
+	name := d.Get(StorageSchemaName).(string)
+	if name != "" {
+		opts = append(opts, func(opt *storages.StorageListHTTPV2Params) { opt.Name = &name })
+	}
+	if resourceID == "" && name == "" {
+		return diag.Errorf("get storage: empty storage id/name")
+	}
+
+	result, err := client.StoragesList(opts...)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("storages list: %w", err))
+	}
+
+	if len(result) == 0 {
+		return diag.Errorf("get storage: storage %s not found", storageSFTPIdentifier(resourceID, name))
+	}
+	if name == "" && len(result) != 1 {
+		return diag.Errorf("get storage: wrong length of search result (%d), want 1", len(result))
+	}
+	st, err := storageSFTPSearchResult(result, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	host, port, err := storageSFTPEndpoint(st.Address)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprint(st.ID))
+	nameParts := strings.Split(st.Name, "-")
+	if len(nameParts) > 1 {
+		clientID, _ := strconv.ParseInt(nameParts[0], 10, 64)
+		_ = d.Set(StorageSchemaClientID, int(clientID))
+		_ = d.Set(StorageSchemaName, strings.Join(nameParts[1:], "-"))
+	} else {
+		_ = d.Set(StorageSchemaName, st.Name)
+	}
+	_ = d.Set(StorageSchemaID, st.ID)
+	_ = d.Set(StorageSchemaLocation, st.Location)
+
+	_ = d.Set(StorageSFTPSchemaHost, host)
+	_ = d.Set(StorageSFTPSchemaPort, port)
+	_ = d.Set(StorageSFTPSchemaLogin, st.Name)
+	_ = d.Set(StorageSFTPSchemaExpires, st.Expires)
+
+	return nil
+}
+
+func storageSFTPIdentifier(resourceID, name string) string {
+	if resourceID != "" {
+		return "with id " + resourceID
+	}
+
+	return "with name " + name
+}
+
+// storageSFTPSearchResult returns the storage found by the name, which is stored with the client id prefix,
+// or the only storage found by the id.
+func storageSFTPSearchResult(result []models.Storage, name string) (*models.Storage, error) {
+	if name == "" {
+		return &result[0], nil
+	}
+	for i, st := range result {
+		if st.Name == name || strings.HasSuffix(st.Name, "-"+name) {
+			return &result[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("get storage: storage with name %s not found", name)
+}
+
+// storageSFTPEndpoint returns the host and the port of the sftp entry point from the storage address.
+func storageSFTPEndpoint(address string) (string, int, error) {
+	if address == "" {
+		return "", 0, errors.New("get storage: empty storage address")
+	}
+	host, rawPort, err := net.SplitHostPort(address)
+	if err != nil {
+		// the address without a port
+		return address, storageSFTPDefaultPort, nil //nolint: nilerr
+	}
+	port, err := strconv.Atoi(rawPort)
+	if err != nil {
+		return "", 0, fmt.Errorf("get storage: invalid port of the storage address %s: %w", address, err)
+	}
+
+	return host, port, nil
+}
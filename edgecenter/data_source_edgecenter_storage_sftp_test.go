@@ -0,0 +1,61 @@
+package edgecenter
+
+import (
+	"testing"
+
+	"github.com/Edge-Center/edgecenter-storage-sdk-go/swagger/models"
+)
+
+func TestStorageSFTPEndpoint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		address  string
+		wantHost string
+		wantPort int
+		wantErr  bool
+	}{
+		{name: "without port", address: "s-ed1.cloud.edgecenter.ru", wantHost: "s-ed1.cloud.edgecenter.ru", wantPort: storageSFTPDefaultPort},
+		{name: "with port", address: "s-ed1.cloud.edgecenter.ru:2222", wantHost: "s-ed1.cloud.edgecenter.ru", wantPort: 2222},
+		{name: "invalid port", address: "s-ed1.cloud.edgecenter.ru:sftp", wantErr: true},
+		{name: "empty", address: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			host, port, err := storageSFTPEndpoint(tt.address)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("storageSFTPEndpoint(%q) = %q, %d, want an error", tt.address, host, port)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("storageSFTPEndpoint(%q) returned an error: %s", tt.address, err)
+			}
+			if host != tt.wantHost || port != tt.wantPort {
+				t.Errorf("storageSFTPEndpoint(%q) = %q, %d, want %q, %d", tt.address, host, port, tt.wantHost, tt.wantPort)
+			}
+		})
+	}
+}
+
+func TestStorageSFTPSearchResult(t *testing.T) {
+	t.Parallel()
+
+	result := []models.Storage{{ID: 1, Name: "123-backups-old"}, {ID: 2, Name: "123-backups"}}
+
+	st, err := storageSFTPSearchResult(result, "backups")
+	if err != nil {
+		t.Fatalf("storageSFTPSearchResult() returned an error: %s", err)
+	}
+	if st.ID != 2 {
+		t.Errorf("storageSFTPSearchResult() = storage %d, want 2", st.ID)
+	}
+
+	if _, err := storageSFTPSearchResult(result, "logs"); err == nil {
+		t.Error("storageSFTPSearchResult() found a storage for the name that doesn't match")
+	}
+}
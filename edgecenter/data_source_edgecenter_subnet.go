@@ -99,7 +99,7 @@ func dataSourceSubnet() *schema.Resource {
 						"nexthop": {
 							Type:        schema.TypeString,
 							Computed:    true,
-							Description: "IPv4 address to forward traffic to if it's destination IP matches 'destination' CIDR",
+							Description: "IP address to forward traffic to if it's destination IP matches 'destination' CIDR",
 						},
 					},
 				},
@@ -109,6 +109,11 @@ func dataSourceSubnet() *schema.Resource {
 				Computed:    true,
 				Description: "The IP address of the gateway for this subnet.",
 			},
+			"ip_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "IP version of the subnet, 4 or 6.",
+			},
 			"metadata_read_only": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -198,6 +203,7 @@ func dataSourceSubnetRead(ctx context.Context, d *schema.ResourceData, m interfa
 	d.Set("region_id", subnet.RegionID)
 	d.Set("project_id", subnet.ProjectID)
 	d.Set("gateway_ip", subnet.GatewayIP.String())
+	d.Set("ip_version", subnet.IPVersion)
 
 	d.Set("connect_to_network_router", true)
 	if subnet.GatewayIP == nil {
@@ -3,6 +3,8 @@ package edgecenter
 import (
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
 	"github.com/Edge-Center/edgecentercloud-go/edgecenter/utils/metadata"
 	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
 )
@@ -94,3 +96,40 @@ func MapInterfaceToMapString(mapInterface interface{}) (*map[string]string, erro
 
 	return &mapString, nil
 }
+
+// withMetadataStamp returns the metadata to be sent to the API on create, with the metadata_stamp of the provider added.
+// The keys set in the configuration take precedence over the stamped ones.
+func withMetadataStamp(m interface{}, metadata map[string]string) map[string]string {
+	stamp := m.(*Config).MetadataStamp
+	if len(stamp) == 0 {
+		return metadata
+	}
+
+	stamped := make(map[string]string, len(metadata)+len(stamp))
+	for k, v := range stamp {
+		stamped[k] = v
+	}
+	for k, v := range metadata {
+		stamped[k] = v
+	}
+
+	return stamped
+}
+
+// withoutMetadataStamp removes the keys stamped by the provider from the metadata read from the API,
+// unless they are set in the metadata_map of the configuration, so the stamp doesn't cause a diff.
+func withoutMetadataStamp(m interface{}, d *schema.ResourceData, metadata map[string]string) map[string]string {
+	stamp := m.(*Config).MetadataStamp
+	if len(stamp) == 0 {
+		return metadata
+	}
+
+	configured := d.Get("metadata_map").(map[string]interface{})
+	for k := range stamp {
+		if _, ok := configured[k]; !ok {
+			delete(metadata, k)
+		}
+	}
+
+	return metadata
+}
@@ -7,9 +7,11 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	dnssdk "github.com/Edge-Center/edgecenter-dns-sdk-go"
 	storageSDK "github.com/Edge-Center/edgecenter-storage-sdk-go"
@@ -24,6 +26,13 @@ const (
 	ProviderOptPermanentToken    = "permanent_api_token"
 	ProviderOptSkipCredsAuthErr  = "ignore_creds_auth_error" // nolint: gosec
 	ProviderOptSingleAPIEndpoint = "api_endpoint"
+	ProviderOptFeatures          = "features"
+	ProviderOptNamePrefix        = "name_prefix"
+	ProviderOptAPITelemetryFile  = "api_telemetry_file"
+	ProviderOptMetadataStamp     = "metadata_stamp"
+	ProviderOptMaxRetries        = "max_retries"
+	ProviderOptRetryWaitMin      = "retry_wait_min"
+	ProviderOptRetryWaitMax      = "retry_wait_max"
 	RegionIDField                = "region_id"
 	RegionNameField              = "region_name"
 	ProjectIDField               = "project_id"
@@ -56,8 +65,11 @@ const (
 	SecurityGroupField           = "security_group"
 	SecurityGroupsField          = "security_groups"
 	SecurityGroupIDsField        = "security_group_ids"
+	SecurityGroupNamesField      = "security_group_names"
 	AllSecurityGroupIDsField     = "all_security_group_ids"
+	NamedSecurityGroupIDsField   = "named_security_group_ids"
 	OverwriteExistingField       = "overwrite_existing"
+	ManagementPolicyField        = "management_policy"
 	MetadataField                = "metadata"
 	ValueField                   = "value"
 	FlavorField                  = "flavor"
@@ -163,43 +175,150 @@ func Provider() *schema.Provider {
 				Description: "DNS API (define only if you want to override DNS API endpoint)",
 				DefaultFunc: schema.EnvDefaultFunc("EC_DNS_API", ""),
 			},
+			ProviderOptNamePrefix: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "A prefix added to the names of the instances, networks, security groups and load balancers created by the provider, " +
+					"for example 'prod-'. The names in the configuration are specified without the prefix. " +
+					"The data sources of these objects and 'security_group_names' look the objects up by the name with the prefix first, " +
+					"then by the name as is.",
+				DefaultFunc: schema.EnvDefaultFunc("EC_NAME_PREFIX", ""),
+			},
+			ProviderOptAPITelemetryFile: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Opt-in telemetry: the path of JSON files with the number and latency of the cloud API calls " +
+					"made by each resource operation, e.g. 'edgecenter_instance.read'. " +
+					"Every provider process, e.g. for plan and apply, writes its summary to its own file with the process ID " +
+					"added before the extension, e.g. 'telemetry.1234.json' for 'telemetry.json'. " +
+					"Only the calls of the cloud API v2 client are counted, the calls of the resources still using the v1 client " +
+					"and the calls to the CDN, storage and DNS APIs are not.",
+				DefaultFunc: schema.EnvDefaultFunc("EC_API_TELEMETRY_FILE", ""),
+			},
+			ProviderOptMaxRetries: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "The number of retries of the cloud API requests failed with 429 or 5xx responses, " +
+					"with the exponential backoff between 'retry_wait_min' and 'retry_wait_max'. Set to 0 to disable the retries.",
+				DefaultFunc:  schema.EnvDefaultFunc("EC_MAX_RETRIES", DefaultRetryPolicy().MaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			ProviderOptRetryWaitMin: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The minimum time in seconds to wait before retrying a failed cloud API request.",
+				DefaultFunc:  schema.EnvDefaultFunc("EC_RETRY_WAIT_MIN", int(DefaultRetryPolicy().WaitMin.Seconds())),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			ProviderOptRetryWaitMax: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The maximum time in seconds to wait before retrying a failed cloud API request.",
+				DefaultFunc:  schema.EnvDefaultFunc("EC_RETRY_WAIT_MAX", int(DefaultRetryPolicy().WaitMax.Seconds())),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			ProviderOptMetadataStamp: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Description: "Opt-in stamping of the Terraform context into the metadata of the instances, volumes, networks, subnets, floating IPs and load balancers " +
+					"when they are created, e.g. to find the configuration of a resource during an incident in a shared project.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "terraform_",
+							Description: "The prefix of the stamped metadata keys.",
+						},
+						"workspace": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The workspace stamped under the 'workspace' key, usually terraform.workspace. Defaults to the TF_WORKSPACE environment variable.",
+							DefaultFunc: schema.EnvDefaultFunc("TF_WORKSPACE", ""),
+						},
+						"values": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Additional values to stamp, e.g. the module path or the key of the state in the backend.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			ProviderOptFeatures: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Opt-in behaviors of the provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"purge_ports_on_instance_delete": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     DefaultFeatures().PurgePortsOnInstanceDelete,
+							Description: "Delete floating IPs and reserved fixed IPs attached to the instance when the instance is deleted.",
+						},
+						"recreate_secret_on_expiry": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  DefaultFeatures().RecreateSecretOnExpiry,
+							Description: "Replace the secret on the next apply once it has expired. " +
+								"If the expiration is set in the configuration, it should be moved forward as well.",
+						},
+						"detach_volumes_before_delete": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     DefaultFeatures().DetachVolumesBeforeDelete,
+							Description: "Detach the volume from all instances before the volume is deleted. If disabled, deleting an attached volume fails.",
+						},
+					},
+				},
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"edgecenter_project":                resourceProject(),
-			"edgecenter_volume":                 resourceVolume(),
-			"edgecenter_network":                resourceNetwork(),
-			"edgecenter_subnet":                 resourceSubnet(),
-			"edgecenter_router":                 resourceRouter(),
-			"edgecenter_instance":               resourceInstance(),
-			"edgecenter_instanceV2":             resourceInstanceV2(),
-			"edgecenter_keypair":                resourceKeypair(),
-			"edgecenter_reservedfixedip":        resourceReservedFixedIP(),
-			"edgecenter_floatingip":             resourceFloatingIP(),
-			"edgecenter_loadbalancer":           resourceLoadBalancer(),
-			"edgecenter_loadbalancerv2":         resourceLoadBalancerV2(),
-			"edgecenter_lblistener":             resourceLbListener(),
-			"edgecenter_lbpool":                 resourceLBPool(),
-			"edgecenter_lbmember":               resourceLBMember(),
-			"edgecenter_securitygroup":          resourceSecurityGroup(),
-			"edgecenter_baremetal":              resourceBmInstance(),
-			"edgecenter_snapshot":               resourceSnapshot(),
-			"edgecenter_servergroup":            resourceServerGroup(),
-			"edgecenter_k8s":                    resourceK8s(),
-			"edgecenter_k8s_pool":               resourceK8sPool(),
-			"edgecenter_secret":                 resourceSecret(),
-			"edgecenter_storage_s3":             resourceStorageS3(),
-			"edgecenter_storage_s3_bucket":      resourceStorageS3Bucket(),
-			DNSZoneResource:                     resourceDNSZone(),
-			DNSZoneRecordResource:               resourceDNSZoneRecord(),
-			"edgecenter_cdn_resource":           resourceCDNResource(),
-			"edgecenter_cdn_origingroup":        resourceCDNOriginGroup(),
-			"edgecenter_cdn_rule":               resourceCDNRule(),
-			"edgecenter_cdn_shielding":          resourceCDNShielding(),
-			"edgecenter_cdn_sslcert":            resourceCDNCert(),
-			LifecyclePolicyResource:             resourceLifecyclePolicy(),
-			"edgecenter_lb_l7policy":            resourceL7Policy(),
-			"edgecenter_lb_l7rule":              resourceL7Rule(),
-			"edgecenter_instance_port_security": resourceInstancePortSecurity(),
+			"edgecenter_project":                  resourceProject(),
+			"edgecenter_project_purge":            resourceProjectPurge(),
+			"edgecenter_quota_notification":       resourceQuotaNotification(),
+			"edgecenter_volume":                   resourceVolume(),
+			"edgecenter_network":                  resourceNetwork(),
+			"edgecenter_subnet":                   resourceSubnet(),
+			"edgecenter_router":                   resourceRouter(),
+			"edgecenter_instance":                 resourceInstance(),
+			"edgecenter_instanceV2":               resourceInstanceV2(),
+			"edgecenter_keypair":                  resourceKeypair(),
+			"edgecenter_reservedfixedip":          resourceReservedFixedIP(),
+			"edgecenter_floatingip":               resourceFloatingIP(),
+			FloatingIPAssociationResource:         resourceFloatingIPAssociation(),
+			"edgecenter_loadbalancer":             resourceLoadBalancer(),
+			"edgecenter_loadbalancerv2":           resourceLoadBalancerV2(),
+			"edgecenter_lblistener":               resourceLbListener(),
+			"edgecenter_lbpool":                   resourceLBPool(),
+			"edgecenter_lbmember":                 resourceLBMember(),
+			"edgecenter_securitygroup":            resourceSecurityGroup(),
+			"edgecenter_baremetal":                resourceBmInstance(),
+			"edgecenter_snapshot":                 resourceSnapshot(),
+			"edgecenter_image":                    resourceImage(),
+			"edgecenter_servergroup":              resourceServerGroup(),
+			"edgecenter_k8s":                      resourceK8s(),
+			"edgecenter_k8s_pool":                 resourceK8sPool(),
+			"edgecenter_secret":                   resourceSecret(),
+			"edgecenter_storage_s3":               resourceStorageS3(),
+			"edgecenter_storage_s3_bucket":        resourceStorageS3Bucket(),
+			"edgecenter_storage_s3_bucket_policy": resourceStorageS3BucketPolicy(),
+			"edgecenter_storage_s3_object":        resourceStorageS3Object(),
+			DNSZoneResource:                       resourceDNSZone(),
+			DNSZoneRecordResource:                 resourceDNSZoneRecord(),
+			"edgecenter_cdn_resource":             resourceCDNResource(),
+			"edgecenter_cdn_origingroup":          resourceCDNOriginGroup(),
+			"edgecenter_cdn_rule":                 resourceCDNRule(),
+			"edgecenter_cdn_shielding":            resourceCDNShielding(),
+			"edgecenter_cdn_sslcert":              resourceCDNCert(),
+			LifecyclePolicyResource:               resourceLifecyclePolicy(),
+			SnapshotPolicyAssignmentResource:      resourceSnapshotPolicyAssignment(),
+			"edgecenter_lb_l7policy":              resourceL7Policy(),
+			"edgecenter_lb_l7rule":                resourceL7Rule(),
+			"edgecenter_instance_port_security":   resourceInstancePortSecurity(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"edgecenter_project":                dataSourceProject(),
@@ -210,29 +329,48 @@ func Provider() *schema.Provider {
 			"edgecenter_network":                dataSourceNetwork(),
 			"edgecenter_subnet":                 dataSourceSubnet(),
 			"edgecenter_router":                 dataSourceRouter(),
+			"edgecenter_routers":                dataSourceRouters(),
 			"edgecenter_loadbalancer":           dataSourceLoadBalancer(),
 			"edgecenter_loadbalancerv2":         dataSourceLoadBalancerV2(),
 			"edgecenter_lblistener":             dataSourceLBListener(),
 			"edgecenter_lbpool":                 dataSourceLBPool(),
+			"edgecenter_lbmember":               dataSourceLBMember(),
+			"edgecenter_lbflavor":               dataSourceLBFlavor(),
 			"edgecenter_instance":               dataSourceInstance(),
 			"edgecenter_instanceV2":             dataSourceInstanceV2(),
+			InstancesDataSource:                 dataSourceInstances(),
 			"edgecenter_floatingip":             dataSourceFloatingIP(),
 			"edgecenter_storage_s3":             dataSourceStorageS3(),
 			"edgecenter_storage_s3_bucket":      dataSourceStorageS3Bucket(),
+			"edgecenter_storage_sftp":           dataSourceStorageSFTP(),
 			"edgecenter_reservedfixedip":        dataSourceReservedFixedIP(),
+			"edgecenter_reservedfixedips":       dataSourceReservedFixedIPs(),
 			"edgecenter_servergroup":            dataSourceServerGroup(),
 			"edgecenter_snapshot":               dataSourceSnapshot(),
 			"edgecenter_k8s":                    dataSourceK8s(),
 			"edgecenter_k8s_pool":               dataSourceK8sPool(),
 			"edgecenter_k8s_client_config":      dataSourceK8sClientConfig(),
+			"edgecenter_k8s_versions":           dataSourceK8sVersions(),
 			"edgecenter_secret":                 dataSourceSecret(),
+			"edgecenter_secrets":                dataSourceSecrets(),
 			"edgecenter_lb_l7policy":            dataSourceL7Policy(),
 			"edgecenter_lb_l7rule":              datasourceL7Rule(),
 			"edgecenter_instance_port_security": dataSourceInstancePortSecurity(),
+			"edgecenter_port":                   dataSourcePort(),
+			"edgecenter_instance_availability":  dataSourceInstanceAvailability(),
 			"edgecenter_cdn_shielding_location": dataShieldingLocation(),
+			"edgecenter_provider_schema":        dataSourceProviderSchema(),
+			DNSResolutionDataSource:             dataSourceDNSResolution(),
 		},
 	}
 
+	for name, r := range p.ResourcesMap {
+		instrumentResource(name, r)
+	}
+	for name, r := range p.DataSourcesMap {
+		instrumentResource(name, r)
+	}
+
 	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := p.TerraformVersion
 		if terraformVersion == "" {
@@ -329,6 +467,23 @@ func providerConfigure(
 		UserAgent:      userAgent,
 		Provider:       provider,
 		CDNClient:      cdnService,
+		Features:       expandFeatures(d.Get(ProviderOptFeatures).([]interface{})),
+		NamePrefix:     d.Get(ProviderOptNamePrefix).(string),
+		MetadataStamp:  expandMetadataStamp(d.Get(ProviderOptMetadataStamp).([]interface{})),
+		Retry: RetryPolicy{
+			MaxRetries: d.Get(ProviderOptMaxRetries).(int),
+			WaitMin:    time.Duration(d.Get(ProviderOptRetryWaitMin).(int)) * time.Second,
+			WaitMax:    time.Duration(d.Get(ProviderOptRetryWaitMax).(int)) * time.Second,
+		},
+		SecurityGroupNames: newSecurityGroupNameCache(),
+	}
+	if config.Retry.WaitMin > config.Retry.WaitMax {
+		return nil, diag.Errorf("%s (%s) must not be greater than %s (%s)",
+			ProviderOptRetryWaitMin, config.Retry.WaitMin, ProviderOptRetryWaitMax, config.Retry.WaitMax)
+	}
+
+	if telemetryFile := d.Get(ProviderOptAPITelemetryFile).(string); telemetryFile != "" {
+		config.Telemetry = newAPITelemetry(telemetryFile)
 	}
 
 	if storageAPI != "" {
@@ -367,6 +522,38 @@ func providerConfigure(
 	return &config, diags
 }
 
+func expandFeatures(raw []interface{}) Features {
+	features := DefaultFeatures()
+	if len(raw) == 0 || raw[0] == nil {
+		return features
+	}
+
+	f := raw[0].(map[string]interface{})
+	features.PurgePortsOnInstanceDelete = f["purge_ports_on_instance_delete"].(bool)
+	features.RecreateSecretOnExpiry = f["recreate_secret_on_expiry"].(bool)
+	features.DetachVolumesBeforeDelete = f["detach_volumes_before_delete"].(bool)
+
+	return features
+}
+
+func expandMetadataStamp(raw []interface{}) map[string]string {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+
+	f := raw[0].(map[string]interface{})
+	prefix := f["key_prefix"].(string)
+	stamp := make(map[string]string)
+	if workspace := f["workspace"].(string); workspace != "" {
+		stamp[prefix+"workspace"] = workspace
+	}
+	for k, v := range f["values"].(map[string]interface{}) {
+		stamp[prefix+k] = v.(string)
+	}
+
+	return stamp
+}
+
 func InitCloudClient(
 	ctx context.Context,
 	d *schema.ResourceData,
@@ -75,11 +75,12 @@ func resourceBmInstance() *schema.Resource {
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Computed:     true,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"flavor_id": {
 				Type:     schema.TypeString,
@@ -408,6 +409,7 @@ func resourceBmInstanceRead(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
+	d.Set("region_name", instance.Region)
 	d.Set("name", instance.Name)
 	d.Set("flavor_id", instance.Flavor.FlavorID)
 	d.Set("status", instance.Status)
@@ -52,7 +52,7 @@ func resourceCDNOriginGroup() *schema.Resource {
 							Type:        schema.TypeBool,
 							Optional:    true,
 							Computed:    true,
-							Description: "true — The option is active. The origin will not be used until one of active origins become unavailable. false — The option is disabled.",
+							Description: "true — The option is active. The origin will not be used until one of active origins become unavailable. false — The option is disabled. At least one origin of the group must not be a backup.",
 						},
 						"id": {
 							Type:     schema.TypeInt,
@@ -66,6 +66,7 @@ func resourceCDNOriginGroup() *schema.Resource {
 		ReadContext:   resourceCDNOriginGroupRead,
 		UpdateContext: resourceCDNOriginGroupUpdate,
 		DeleteContext: resourceCDNOriginGroupDelete,
+		CustomizeDiff: resourceCDNOriginGroupCustomizeDiff,
 		Description:   "Represent origin group",
 	}
 }
@@ -167,6 +168,28 @@ func resourceCDNOriginGroupDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// resourceCDNOriginGroupCustomizeDiff checks that the group has an active origin,
+// because the backup origins are used only when an active one becomes unavailable.
+func resourceCDNOriginGroupCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("origin") {
+		return nil
+	}
+
+	origins := d.Get("origin").(*schema.Set).List()
+	for _, origin := range origins {
+		fields := origin.(map[string]interface{})
+		if source, ok := fields["source"].(string); ok && source == "" {
+			// The source depends on a resource, which is not created yet.
+			return nil
+		}
+		if backup, _ := fields["backup"].(bool); !backup {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("origin group must have at least one origin which is not a backup")
+}
+
 func setToOriginRequests(s *schema.Set) []origingroups.OriginRequest {
 	origins := make([]origingroups.OriginRequest, 0)
 	for _, fields := range s.List() {
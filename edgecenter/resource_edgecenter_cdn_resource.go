@@ -2,16 +2,21 @@ package edgecenter
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
 	"strconv"
 
+	"github.com/AlekSi/pointer"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	cdn "github.com/Edge-Center/edgecentercdn-go/edgecenter"
+	"github.com/Edge-Center/edgecentercdn-go/origingroups"
 	"github.com/Edge-Center/edgecentercdn-go/resources"
+	"github.com/Edge-Center/edgecentercdn-go/shielding"
 )
 
 var resourceOptionsSchema = &schema.Schema{
@@ -673,10 +678,11 @@ var resourceOptionsSchema = &schema.Schema{
 				},
 			},
 			"secure_key": {
-				Type:        schema.TypeList,
-				MaxItems:    1,
-				Optional:    true,
-				Description: "",
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Description: "Protects the content with signed URLs (secure tokens). The CDN resource has a single key, " +
+					"so URLs signed with the previous key are rejected as soon as the key is changed.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"enabled": {
@@ -685,14 +691,17 @@ var resourceOptionsSchema = &schema.Schema{
 							Default:  true,
 						},
 						"key": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "",
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(6, 32),
+							Description:  "The key used to sign URLs, from 6 to 32 characters.",
 						},
 						"type": {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "",
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntInSlice([]int{0, 2}),
+							Description:  "The type of the URL signing. Possible values are 0 (the client IP address is included in the signature) and 2 (the client IP address is not included).",
 						},
 					},
 				},
@@ -958,6 +967,7 @@ func resourceCDNResource() *schema.Resource {
 				ExactlyOneOf: []string{
 					"origin_group",
 					"origin",
+					"origin_storage_id",
 				},
 				Description: "ID of the Origins Group. Use one of your Origins Group or create a new one. You can use either 'origin' parameter or 'originGroup' in the resource definition.",
 			},
@@ -968,9 +978,20 @@ func resourceCDNResource() *schema.Resource {
 				ExactlyOneOf: []string{
 					"origin_group",
 					"origin",
+					"origin_storage_id",
 				},
 				Description: "A domain name or IP of your origin source. Specify a port if custom. You can use either 'origin' parameter or 'originGroup' in the resource definition.",
 			},
+			"origin_storage_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"origin_group",
+					"origin",
+					"origin_storage_id",
+				},
+				Description: "An id of the edgecenter_storage_s3 storage used as an origin source. The origin group is configured from the storage endpoint when the storage is set or changed.",
+			},
 			"origin_protocol": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -1026,12 +1047,20 @@ func resourceCDNResource() *schema.Resource {
 				Computed:    true,
 				Description: "",
 			},
+			"shielding_pop": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				Description: "ID of the origin shielding location, see the edgecenter_cdn_shielding_location data source. " +
+					"Set 0 to disable origin shielding. Don't use it together with the edgecenter_cdn_shielding resource for the same CDN resource.",
+			},
 			"options": resourceOptionsSchema,
 		},
 		CreateContext: resourceCDNResourceCreate,
 		ReadContext:   resourceCDNResourceRead,
 		UpdateContext: resourceCDNResourceUpdate,
 		DeleteContext: resourceCDNResourceDelete,
+		CustomizeDiff: resourceCDNResourceCustomizeDiff,
 		Description:   "Represent CDN resource",
 	}
 }
@@ -1046,6 +1075,13 @@ func resourceCDNResourceCreate(ctx context.Context, d *schema.ResourceData, m in
 	req.Description = d.Get("description").(string)
 	req.Origin = d.Get("origin").(string)
 	req.OriginGroup = d.Get("origin_group").(int)
+	if storageID, ok := d.GetOk("origin_storage_id"); ok {
+		address, err := storageAddress(ctx, config.StorageClient, storageID.(int))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.Origin = address
+	}
 	req.OriginProtocol = resources.Protocol(d.Get("origin_protocol").(string))
 	req.SSlEnabled = d.Get("ssl_enabled").(bool)
 	req.SSLData = d.Get("ssl_data").(int)
@@ -1067,6 +1103,13 @@ func resourceCDNResourceCreate(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	d.SetId(fmt.Sprintf("%d", result.ID))
+
+	if pop, ok := d.GetOk("shielding_pop"); ok {
+		if err := updateCDNResourceShielding(ctx, client.Shielding(), result.ID, pop.(int)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	resourceCDNResourceRead(ctx, d, m)
 
 	log.Printf("[DEBUG] Finish CDN Resource creating (id=%d)\n", result.ID)
@@ -1094,6 +1137,15 @@ func resourceCDNResourceRead(ctx context.Context, d *schema.ResourceData, m inte
 	d.Set("description", result.Description)
 	d.Set("origin_group", result.OriginGroup)
 	d.Set("origin_protocol", result.OriginProtocol)
+	if _, ok := d.GetOk("origin_storage_id"); ok {
+		originGroup, err := client.OriginGroups().Get(ctx, result.OriginGroup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(originGroup.Origins) > 0 {
+			d.Set("origin", originGroup.Origins[0].Source)
+		}
+	}
 	d.Set("secondary_hostnames", result.SecondaryHostnames)
 	d.Set("ssl_enabled", result.SSlEnabled)
 	d.Set("ssl_data", result.SSLData)
@@ -1105,6 +1157,22 @@ func resourceCDNResourceRead(ctx context.Context, d *schema.ResourceData, m inte
 		return diag.FromErr(err)
 	}
 
+	// the shielding is read only when it is managed, since it is missing on the accounts and resources without it
+	if _, ok := d.GetOk("shielding_pop"); ok {
+		shieldingData, err := client.Shielding().Get(ctx, id)
+		var errResp *cdn.ErrorResponse
+		switch {
+		case errors.As(err, &errResp):
+			// the client doesn't keep the status code, so any error response of the API, e.g. 403 or 404, means no shielding
+			log.Printf("[WARN] Cannot get shielding of CDN resource %d, treating it as disabled: %s", id, err)
+			d.Set("shielding_pop", 0)
+		case err != nil:
+			return diag.FromErr(err)
+		default:
+			d.Set("shielding_pop", pointer.GetInt(shieldingData.ShieldingPop))
+		}
+	}
+
 	log.Println("[DEBUG] Finish CDN Resource reading")
 
 	return nil
@@ -1125,6 +1193,13 @@ func resourceCDNResourceUpdate(ctx context.Context, d *schema.ResourceData, m in
 	req.Active = d.Get("active").(bool)
 	req.Description = d.Get("description").(string)
 	req.OriginGroup = d.Get("origin_group").(int)
+	if storageID, ok := d.GetOk("origin_storage_id"); ok && d.HasChanges("origin_storage_id", "origin") {
+		originGroupID, err := syncCDNResourceStorageOrigin(ctx, d, m, storageID.(int))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.OriginGroup = originGroupID
+	}
 	req.SSlEnabled = d.Get("ssl_enabled").(bool)
 	req.SSLData = d.Get("ssl_data").(int)
 	req.SSLAutomated = d.Get("ssl_automated").(bool)
@@ -1138,6 +1213,12 @@ func resourceCDNResourceUpdate(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("shielding_pop") {
+		if err := updateCDNResourceShielding(ctx, client.Shielding(), id, d.Get("shielding_pop").(int)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	log.Println("[DEBUG] Finish CDN Resource updating")
 
 	return resourceCDNResourceRead(ctx, d, m)
@@ -1164,6 +1245,78 @@ func resourceCDNResourceDelete(ctx context.Context, d *schema.ResourceData, m in
 	return nil
 }
 
+// updateCDNResourceShielding sets the origin shielding location of the CDN resource,
+// the zero location disables origin shielding.
+func updateCDNResourceShielding(ctx context.Context, service shielding.ShieldingService, resourceID int64, pop int) error {
+	var req shielding.UpdateShieldingData
+	if pop != 0 {
+		req.ShieldingPop = pointer.ToInt(pop)
+	}
+	if _, err := service.Update(ctx, resourceID, &req); err != nil {
+		return fmt.Errorf("update origin shielding: %w", err)
+	}
+
+	return nil
+}
+
+// resourceCDNResourceCustomizeDiff plans the origin of the storage referenced by origin_storage_id.
+// The storage is requested only when the resource is created or its origin is changed,
+// so that the plan of the unchanged resource doesn't call the storage API.
+func resourceCDNResourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	storageID, ok := d.GetOk("origin_storage_id")
+	if !ok || !d.NewValueKnown("origin_storage_id") {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChanges("origin_storage_id", "origin") {
+		return nil
+	}
+
+	config := m.(*Config)
+	address, err := storageAddress(ctx, config.StorageClient, storageID.(int))
+	if err != nil {
+		return err
+	}
+	if d.Get("origin").(string) != address {
+		return d.SetNew("origin", address)
+	}
+
+	return nil
+}
+
+// syncCDNResourceStorageOrigin points the origin group of the CDN resource to the storage endpoint.
+// A dedicated origin group is created when the resource is switched to a storage origin,
+// so that an origin group shared with other resources is never overwritten.
+func syncCDNResourceStorageOrigin(ctx context.Context, d *schema.ResourceData, m interface{}, storageID int) (int, error) {
+	config := m.(*Config)
+	client := config.CDNClient
+
+	address, err := storageAddress(ctx, config.StorageClient, storageID)
+	if err != nil {
+		return 0, err
+	}
+
+	req := origingroups.GroupRequest{
+		Name:    fmt.Sprintf("%s-storage-%d", d.Get("cname").(string), storageID),
+		Origins: []origingroups.OriginRequest{{Source: address, Enabled: true}},
+	}
+
+	if oldStorageID, _ := d.GetChange("origin_storage_id"); oldStorageID.(int) == 0 {
+		result, err := client.OriginGroups().Create(ctx, &req)
+		if err != nil {
+			return 0, fmt.Errorf("create origin group: %w", err)
+		}
+
+		return int(result.ID), nil
+	}
+
+	originGroupID := d.Get("origin_group").(int)
+	if _, err := client.OriginGroups().Update(ctx, int64(originGroupID), &req); err != nil {
+		return 0, fmt.Errorf("update origin group: %w", err)
+	}
+
+	return originGroupID, nil
+}
+
 func listToResourceOptions(l []interface{}) *cdn.ResourceOptions {
 	if len(l) == 0 {
 		return nil
@@ -60,14 +60,17 @@ allowing it to have a static public IP address. The floating IP can be re-associ
 			"region_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"floating_ip_address": {
 				Type:        schema.TypeString,
@@ -124,6 +127,17 @@ allowing it to have a static public IP address. The floating IP can be re-associ
 				Computed:    true,
 				Description: "The ID (uuid) of the router that the floating IP is associated with.",
 			},
+			"attached": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the floating IP is attached to a port. Can be used to find idle floating IPs.",
+			},
+			"last_attached_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The timestamp when the floating IP was last seen attached to a port. " +
+					"The value is kept after the floating IP is detached.",
+			},
 			"created_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -194,6 +208,7 @@ func resourceFloatingIPCreate(ctx context.Context, d *schema.ResourceData, m int
 		}
 		opts.Metadata = *meta
 	}
+	opts.Metadata = withMetadataStamp(m, opts.Metadata)
 
 	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Floatingips.Create, opts, clientV2, FloatingIPCreateTimeout)
 	if err != nil {
@@ -245,6 +260,7 @@ func resourceFloatingIPRead(ctx context.Context, d *schema.ResourceData, m inter
 	}
 	d.Set("project_id", floatingIP.ProjectID)
 	d.Set("region_id", floatingIP.RegionID)
+	d.Set("region_name", floatingIP.Region)
 	d.Set("status", floatingIP.Status)
 	if floatingIP.Instance.ID != "" {
 		d.Set("instance_id_attached_to", floatingIP.Instance.ID)
@@ -255,10 +271,17 @@ func resourceFloatingIPRead(ctx context.Context, d *schema.ResourceData, m inter
 		d.Set("load_balancer_port_id", floatingIP.PortID)
 	}
 	d.Set("port_id", floatingIP.PortID)
+	d.Set("attached", floatingIP.PortID != "")
+	if floatingIP.PortID != "" {
+		d.Set("last_attached_at", floatingIP.UpdatedAt)
+	}
+	d.Set("created_at", floatingIP.CreatedAt)
+	d.Set("updated_at", floatingIP.UpdatedAt)
 	d.Set("router_id", floatingIP.RouterID)
 	d.Set("floating_ip_address", floatingIP.FloatingIPAddress)
 
 	metadataMap, metadataReadOnly := PrepareMetadata(floatingIP.Metadata)
+	metadataMap = withoutMetadataStamp(m, d, metadataMap)
 
 	if err = d.Set("metadata_map", metadataMap); err != nil {
 		return diag.FromErr(err)
@@ -312,7 +335,7 @@ func resourceFloatingIPUpdate(ctx context.Context, d *schema.ResourceData, m int
 			return diag.Errorf("cannot get metadata. Error: %s", err)
 		}
 
-		metaChanged := edgecloudV2.Metadata(*meta)
+		metaChanged := edgecloudV2.Metadata(withMetadataStamp(m, *meta))
 		_, err = clientV2.Floatingips.MetadataUpdate(ctx, d.Id(), &metaChanged)
 		if err != nil {
 			return diag.Errorf("cannot update metadata. Error: %s", err)
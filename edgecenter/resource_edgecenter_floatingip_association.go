@@ -0,0 +1,287 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	FloatingIPAssociationResource = "edgecenter_floatingip_association"
+	FloatingIPIDField             = "floating_ip_id"
+	FixedIPAddressField           = "fixed_ip_address"
+	InstanceIDAttachedToField     = "instance_id_attached_to"
+)
+
+func resourceFloatingIPAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFloatingIPAssociationCreate,
+		ReadContext:   resourceFloatingIPAssociationRead,
+		UpdateContext: resourceFloatingIPAssociationUpdate,
+		DeleteContext: resourceFloatingIPAssociationDelete,
+		Description: "Represent the association of a floating IP with a network port, e.g. of an instance. " +
+			"The floating IP can be moved to another port without recreating it. " +
+			"The associated floating IP should not have 'port_id' and 'fixed_ip_address' and should ignore their changes " +
+			"with 'lifecycle { ignore_changes = [port_id, fixed_ip_address] }'.",
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				projectID, regionID, fipID, portID, err := ImportStringParserExtended(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set(ProjectIDField, projectID)
+				d.Set(RegionIDField, regionID)
+				d.Set(FloatingIPIDField, fipID)
+				d.Set(PortIDField, portID)
+				d.SetId(fipID)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			ProjectIDField: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{ProjectIDField, ProjectNameField},
+			},
+			ProjectNameField: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{ProjectIDField, ProjectNameField},
+			},
+			RegionIDField: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+			},
+			RegionNameField: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{RegionIDField, RegionNameField},
+				DiffSuppressFunc: suppressRegionNameDiffs,
+			},
+			FloatingIPIDField: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The ID (uuid) of the floating IP.",
+				ValidateFunc: validation.IsUUID,
+			},
+			PortIDField: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID (uuid) of the network port that the floating IP is associated with. Changing it moves the floating IP to the new port.",
+				ValidateFunc: validation.IsUUID,
+			},
+			FixedIPAddressField: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The fixed IP address of the port that the floating IP is associated with. Required if the port has several fixed IP addresses.",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			InstanceIDAttachedToField: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID (uuid) of the instance, that the floating IP is associated with.",
+			},
+		},
+	}
+}
+
+func resourceFloatingIPAssociationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start FloatingIP association creating")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fipID := d.Get(FloatingIPIDField).(string)
+	if err := assignFloatingIP(ctx, clientV2, d, fipID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fipID)
+	log.Printf("[DEBUG] Finish FloatingIP association creating (%s)", fipID)
+
+	return resourceFloatingIPAssociationRead(ctx, d, m)
+}
+
+func resourceFloatingIPAssociationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Start FloatingIP association reading (%s)", d.Id())
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	floatingIP, resp, err := clientV2.Floatingips.Get(ctx, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing FloatingIP association %s because floating ip doesn't exist anymore", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if floatingIP.PortID == "" {
+		log.Printf("[WARN] Removing FloatingIP association %s because floating ip isn't associated anymore", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := setRegionFields(ctx, clientV2, d); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set(ProjectIDField, floatingIP.ProjectID)
+	d.Set(FloatingIPIDField, floatingIP.ID)
+	d.Set(PortIDField, floatingIP.PortID)
+	if floatingIP.FixedIPAddress != nil {
+		d.Set(FixedIPAddressField, floatingIP.FixedIPAddress.String())
+	} else {
+		d.Set(FixedIPAddressField, "")
+	}
+	d.Set(InstanceIDAttachedToField, floatingIP.Instance.ID)
+
+	log.Printf("[DEBUG] Finish FloatingIP association reading (%s)", d.Id())
+
+	return nil
+}
+
+func resourceFloatingIPAssociationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Start FloatingIP association updating (%s)", d.Id())
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges(PortIDField, FixedIPAddressField) {
+		oldPortID, _ := d.GetChange(PortIDField)
+		oldFixedIP, _ := d.GetChange(FixedIPAddressField)
+		previous := &edgecloudV2.AssignFloatingIPRequest{
+			PortID:         oldPortID.(string),
+			FixedIPAddress: net.ParseIP(oldFixedIP.(string)),
+		}
+		if err := reassignFloatingIP(ctx, clientV2, d.Id(), floatingIPAssignRequest(d), previous, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			// the previous port is kept in the state
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[DEBUG] Finish FloatingIP association updating (%s)", d.Id())
+
+	return resourceFloatingIPAssociationRead(ctx, d, m)
+}
+
+func resourceFloatingIPAssociationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Start FloatingIP association deleting (%s)", d.Id())
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	floatingIP, resp, err := clientV2.Floatingips.Get(ctx, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// the floating ip may be already moved to another port outside of this resource
+	if floatingIP.PortID == d.Get(PortIDField).(string) {
+		if _, _, err := clientV2.Floatingips.UnAssign(ctx, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	log.Println("[DEBUG] Finish FloatingIP association deleting")
+
+	return nil
+}
+
+// assignFloatingIP associates the floating ip with the port of the configuration.
+func assignFloatingIP(ctx context.Context, client *edgecloudV2.Client, d *schema.ResourceData, fipID string, timeout time.Duration) error {
+	return assignFloatingIPToPort(ctx, client, fipID, floatingIPAssignRequest(d), timeout)
+}
+
+func floatingIPAssignRequest(d *schema.ResourceData) *edgecloudV2.AssignFloatingIPRequest {
+	opts := &edgecloudV2.AssignFloatingIPRequest{PortID: d.Get(PortIDField).(string)}
+	// the computed fixed ip of the previous port is not used for the new one
+	if !d.GetRawConfig().GetAttr(FixedIPAddressField).IsNull() {
+		opts.FixedIPAddress = net.ParseIP(d.Get(FixedIPAddressField).(string))
+	}
+
+	return opts
+}
+
+// reassignFloatingIP moves the floating ip to another port. If the floating ip can't be associated with the new port,
+// it is associated with the previous one again, so that it isn't left without a port.
+func reassignFloatingIP(ctx context.Context, client *edgecloudV2.Client, fipID string, opts, previous *edgecloudV2.AssignFloatingIPRequest, timeout time.Duration) error {
+	if _, _, err := client.Floatingips.UnAssign(ctx, fipID); err != nil {
+		return fmt.Errorf("cannot disassociate floating ip %s from port %s: %w", fipID, previous.PortID, err)
+	}
+	err := assignFloatingIPToPort(ctx, client, fipID, opts, timeout)
+	if err == nil {
+		return nil
+	}
+
+	log.Printf("[WARN] Associating floating ip %s with the previous port %s: %s", fipID, previous.PortID, err)
+	if restoreErr := assignFloatingIPToPort(ctx, client, fipID, previous, timeout); restoreErr != nil {
+		return fmt.Errorf("%w; the floating ip is left without a port: %s", err, restoreErr)
+	}
+
+	return err
+}
+
+// assignFloatingIPToPort associates the floating ip with the port and waits until the floating ip reports the port.
+func assignFloatingIPToPort(ctx context.Context, client *edgecloudV2.Client, fipID string, opts *edgecloudV2.AssignFloatingIPRequest, timeout time.Duration) error {
+	if _, _, err := client.Floatingips.Assign(ctx, fipID, opts); err != nil {
+		return fmt.Errorf("cannot associate floating ip %s with port %s: %w", fipID, opts.PortID, err)
+	}
+
+	fetch := floatingIPPortRefreshFunc(ctx, client, fipID)
+	if _, err := WaitForResourceStatus(ctx, fetch, []string{opts.PortID}, nil, timeout, 0); err != nil {
+		return fmt.Errorf("error waiting for floating ip (%s) to become associated with port %s: %w", fipID, opts.PortID, err)
+	}
+
+	return nil
+}
+
+// floatingIPPortRefreshFunc returns a ResourceStatusFetchFunc to track the port of the floating ip.
+func floatingIPPortRefreshFunc(ctx context.Context, client *edgecloudV2.Client, fipID string) ResourceStatusFetchFunc[*edgecloudV2.FloatingIP, string] {
+	return func() (*edgecloudV2.FloatingIP, string, error) {
+		floatingIP, _, err := client.Floatingips.Get(ctx, fipID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return floatingIP, floatingIP.PortID, nil
+	}
+}
@@ -0,0 +1,81 @@
+package edgecenter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+// TestReassignFloatingIPRestoresPreviousPort checks that the floating ip is associated with the previous port again
+// when it can't be associated with the new one.
+func TestReassignFloatingIPRestoresPreviousPort(t *testing.T) {
+	t.Parallel()
+
+	const (
+		fipID   = "9b1f6f7c-2c3e-4d0a-8a8e-1a2b3c4d5e6f"
+		oldPort = "old-port"
+		newPort = "new-port"
+	)
+	var (
+		mu       sync.Mutex
+		portID   = oldPort
+		assigned []string
+	)
+	basePath := fmt.Sprintf("/v1/floatingips/1/1/%s", fipID)
+	mux := http.NewServeMux()
+	mux.HandleFunc(basePath+"/unassign", func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		portID = ""
+		json.NewEncoder(w).Encode(edgecloudV2.FloatingIP{ID: fipID})
+	})
+	mux.HandleFunc(basePath+"/assign", func(w http.ResponseWriter, r *http.Request) {
+		var req edgecloudV2.AssignFloatingIPRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("cannot decode the assign request: %s", err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		assigned = append(assigned, req.PortID)
+		if req.PortID == newPort {
+			http.Error(w, `{"message": "port is in use"}`, http.StatusConflict)
+			return
+		}
+		portID = req.PortID
+		json.NewEncoder(w).Encode(edgecloudV2.FloatingIP{ID: fipID, PortID: portID})
+	})
+	mux.HandleFunc(basePath, func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		json.NewEncoder(w).Encode(edgecloudV2.FloatingIP{ID: fipID, PortID: portID})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := edgecloudV2.NewClient(server.Client())
+	client.BaseURL, _ = url.Parse(server.URL)
+	client.Project, client.Region = 1, 1
+
+	err := reassignFloatingIP(context.Background(), client, fipID,
+		&edgecloudV2.AssignFloatingIPRequest{PortID: newPort}, &edgecloudV2.AssignFloatingIPRequest{PortID: oldPort}, time.Minute)
+	if err == nil {
+		t.Fatal("reassignFloatingIP() succeeded, want the error of the new port")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if want := []string{newPort, oldPort}; !reflect.DeepEqual(assigned, want) {
+		t.Errorf("assigned ports = %v, want %v", assigned, want)
+	}
+	if portID != oldPort {
+		t.Errorf("the floating ip is associated with %q, want the previous port %q", portID, oldPort)
+	}
+}
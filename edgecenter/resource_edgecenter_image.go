@@ -0,0 +1,373 @@
+package edgecenter
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+	utilV2 "github.com/Edge-Center/edgecentercloud-go/v2/util"
+)
+
+const (
+	imageCreatingTimeout = 3600 * time.Second
+	imageDeletingTimeout = 1200 * time.Second
+)
+
+func resourceImage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceImageCreate,
+		ReadContext:   resourceImageRead,
+		UpdateContext: resourceImageUpdate,
+		DeleteContext: resourceImageDelete,
+		Description: "Represent image. The image is uploaded from the url or created from the volume, " +
+			"its name, properties and metadata are updated in place without re-upload.",
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				projectID, regionID, imageID, err := ImportStringParser(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("project_id", projectID)
+				d.Set("region_id", regionID)
+				d.SetId(imageID)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"project_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"region_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{"region_id", "region_name"},
+			},
+			"region_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the image.",
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The url to upload the image from. Either 'url' or 'volume_id' must be specified.",
+				ExactlyOneOf: []string{"url", "volume_id"},
+			},
+			"volume_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The ID of the volume to create the image from. Either 'url' or 'volume_id' must be specified.",
+				ExactlyOneOf: []string{"url", "volume_id"},
+			},
+			"os_distro": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The OS distribution of the uploaded image, for example 'ubuntu'.",
+			},
+			"os_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The OS version of the uploaded image, for example '22.04'.",
+			},
+			"os_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The OS type of the image. Available values are 'linux', 'windows'.",
+				ValidateFunc: validation.StringInSlice([]string{string(edgecloudV2.OSTypeLinux), string(edgecloudV2.OSTypeWindows)}, false),
+			},
+			"hw_machine_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The virtual chipset type. Available values are 'i440', 'q35'.",
+				ValidateFunc: validation.StringInSlice([]string{string(edgecloudV2.HWMachineTypeI440), string(edgecloudV2.HWMachineTypeQ35)}, false),
+			},
+			"hw_firmware_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The type of firmware with which to boot the guest. Available values are 'bios', 'uefi'.",
+				ValidateFunc: validation.StringInSlice([]string{string(edgecloudV2.HWFirmwareTypeBios), string(edgecloudV2.HWFirmwareTypeUEFI)}, false),
+			},
+			"ssh_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Whether the image supports SSH key or not. Available values are 'allow', 'deny', 'required'.",
+				ValidateFunc: validation.StringInSlice([]string{string(edgecloudV2.SSHKeyAllow), string(edgecloudV2.SSHKeyDeny), string(edgecloudV2.SSHKeyRequired)}, false),
+			},
+			"is_baremetal": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the image is intended for bare metal servers.",
+			},
+			"metadata_map": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Computed:    true,
+				Description: "A map containing metadata, for example tags.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"metadata_read_only": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `A list of read-only metadata items, e.g. tags.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"read_only": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the image in bytes.",
+			},
+			"min_disk": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The minimal size of the disk in GB required to boot the image.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current status of the image.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The timestamp of the last update (use with update context).",
+			},
+		},
+	}
+}
+
+func resourceImageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start image creating")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var taskResult *utilV2.TaskResult
+	if url := d.Get("url").(string); url != "" {
+		opts := &edgecloudV2.ImageUploadRequest{
+			Name:           d.Get("name").(string),
+			URL:            url,
+			OSDistro:       d.Get("os_distro").(string),
+			OSVersion:      d.Get("os_version").(string),
+			OSType:         edgecloudV2.OSType(imageProperty(d, "os_type", string(edgecloudV2.OSTypeLinux))),
+			SSHKey:         edgecloudV2.SSHKey(imageProperty(d, "ssh_key", string(edgecloudV2.SSHKeyAllow))),
+			HWMachineType:  edgecloudV2.HWMachineType(imageProperty(d, "hw_machine_type", string(edgecloudV2.HWMachineTypeI440))),
+			HWFirmwareType: edgecloudV2.HWFirmwareType(imageProperty(d, "hw_firmware_type", string(edgecloudV2.HWFirmwareTypeBios))),
+			IsBaremetal:    d.Get("is_baremetal").(bool),
+			Metadata:       prepareRawMetadata(d.Get("metadata_map").(map[string]interface{})),
+		}
+		taskResult, err = utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Images.Upload, opts, clientV2, imageCreatingTimeout)
+	} else {
+		opts := &edgecloudV2.ImageCreateRequest{
+			Name:           d.Get("name").(string),
+			VolumeID:       d.Get("volume_id").(string),
+			Source:         "volume",
+			OSType:         edgecloudV2.OSType(imageProperty(d, "os_type", string(edgecloudV2.OSTypeLinux))),
+			SSHKey:         edgecloudV2.SSHKey(imageProperty(d, "ssh_key", string(edgecloudV2.SSHKeyAllow))),
+			HWMachineType:  edgecloudV2.HWMachineType(imageProperty(d, "hw_machine_type", string(edgecloudV2.HWMachineTypeI440))),
+			HWFirmwareType: edgecloudV2.HWFirmwareType(imageProperty(d, "hw_firmware_type", string(edgecloudV2.HWFirmwareTypeBios))),
+			IsBaremetal:    d.Get("is_baremetal").(bool),
+			Metadata:       prepareRawMetadata(d.Get("metadata_map").(map[string]interface{})),
+		}
+		taskResult, err = utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Images.Create, opts, clientV2, imageCreatingTimeout)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	imageID := taskResult.Images[0]
+	log.Printf("[DEBUG] Image id (%s)", imageID)
+
+	d.SetId(imageID)
+
+	log.Printf("[DEBUG] Finish image creating (%s)", imageID)
+
+	return resourceImageRead(ctx, d, m)
+}
+
+func resourceImageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start image reading")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	imageID := d.Id()
+	log.Printf("[DEBUG] Image id = %s", imageID)
+	image, resp, err := clientV2.Images.Get(ctx, imageID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Image (%s) not found, removing from state", imageID)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("cannot get image with ID: %s. Error: %s", imageID, err)
+	}
+
+	d.Set("name", image.Name)
+	d.Set("os_distro", image.OSDistro)
+	d.Set("os_version", image.OSVersion)
+	d.Set("os_type", image.OSType)
+	d.Set("hw_machine_type", image.HWMachineType)
+	d.Set("hw_firmware_type", image.HWFirmwareType)
+	d.Set("ssh_key", image.SSHKey)
+	d.Set("is_baremetal", image.IsBaremetal)
+	d.Set("size", image.Size)
+	d.Set("min_disk", image.MinDisk)
+	d.Set("status", image.Status)
+	d.Set("region_id", image.RegionID)
+	d.Set("region_name", image.Region)
+	d.Set("project_id", image.ProjectID)
+
+	metadataMap, metadataReadOnly := PrepareMetadata(image.MetadataDetailed)
+	if err := d.Set("metadata_map", metadataMap); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata_read_only", metadataReadOnly); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Println("[DEBUG] Finish image reading")
+
+	return nil
+}
+
+func resourceImageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start image updating")
+	imageID := d.Id()
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "os_type", "hw_machine_type", "hw_firmware_type", "ssh_key", "is_baremetal") {
+		opts := &edgecloudV2.ImageUpdateRequest{
+			Name:           d.Get("name").(string),
+			OSType:         edgecloudV2.OSType(imageProperty(d, "os_type", string(edgecloudV2.OSTypeLinux))),
+			SSHKey:         edgecloudV2.SSHKey(imageProperty(d, "ssh_key", string(edgecloudV2.SSHKeyAllow))),
+			HWMachineType:  edgecloudV2.HWMachineType(imageProperty(d, "hw_machine_type", string(edgecloudV2.HWMachineTypeI440))),
+			HWFirmwareType: edgecloudV2.HWFirmwareType(imageProperty(d, "hw_firmware_type", string(edgecloudV2.HWFirmwareTypeBios))),
+			IsBaremetal:    d.Get("is_baremetal").(bool),
+			Metadata:       prepareRawMetadata(d.Get("metadata_map").(map[string]interface{})),
+		}
+		if _, _, err := clientV2.Images.Update(ctx, imageID, opts); err != nil {
+			return diag.Errorf("cannot update image with ID: %s. Error: %s", imageID, err)
+		}
+	}
+
+	if d.HasChange("metadata_map") {
+		newMeta := edgecloudV2.Metadata(prepareRawMetadata(d.Get("metadata_map").(map[string]interface{})))
+		if _, err := clientV2.Images.MetadataUpdate(ctx, imageID, &newMeta); err != nil {
+			return diag.Errorf("cannot update metadata of image with ID: %s. Error: %s", imageID, err)
+		}
+	}
+
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	log.Println("[DEBUG] Finish image updating")
+
+	return resourceImageRead(ctx, d, m)
+}
+
+func resourceImageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start image deleting")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	imageID := d.Id()
+	log.Printf("[DEBUG] Image id = %s", imageID)
+	results, resp, err := clientV2.Images.Delete(ctx, imageID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			log.Printf("[DEBUG] Finish of image deleting")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	taskID := results.Tasks[0]
+
+	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, imageDeletingTimeout)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	log.Printf("[DEBUG] Finish of image deleting")
+
+	return nil
+}
+
+// imageProperty returns the value of the image property or its default if the property is not set,
+// because the API requires all properties to be passed.
+func imageProperty(d *schema.ResourceData, key, defaultValue string) string {
+	if v := d.Get(key).(string); v != "" {
+		return v
+	}
+
+	return defaultValue
+}
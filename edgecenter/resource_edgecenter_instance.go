@@ -9,11 +9,11 @@ import (
 	"reflect"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -29,6 +29,9 @@ const (
 
 	InstanceVMStateActive  = "active"
 	InstanceVMStateStopped = "stopped"
+	InstanceVMStateShelved = "shelved"
+	// InstanceVMStateShelvedOffloaded is the state of the shelved instance after its compute resources are released.
+	InstanceVMStateShelvedOffloaded = "shelved_offloaded"
 )
 
 func resourceInstance() *schema.Resource {
@@ -37,8 +40,14 @@ func resourceInstance() *schema.Resource {
 		ReadContext:        resourceInstanceRead,
 		UpdateContext:      resourceInstanceUpdate,
 		DeleteContext:      resourceInstanceDelete,
+		CustomizeDiff:      resourceInstanceCustomizeDiff,
 		Description:        "A cloud instance is a virtual machine in a cloud environment.",
 		DeprecationMessage: "!> **WARNING:** This resource is deprecated and will be removed in the next major version. Use edgecenter_instanceV2 resource instead",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(InstanceCreateTimeout),
+			Update: schema.DefaultTimeout(InstanceUpdateTimeout),
+			Delete: schema.DefaultTimeout(InstanceDeleteTimeout),
+		},
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -77,11 +86,12 @@ func resourceInstance() *schema.Resource {
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Computed:     true,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -90,9 +100,10 @@ func resourceInstance() *schema.Resource {
 				Description: "The name of the instance.",
 			},
 			"flavor_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The ID of the flavor to be used for the instance, determining its compute and memory, for example 'g1-standard-2-4'.",
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "The ID of the flavor to be used for the instance, determining its compute and memory, for example 'g1-standard-2-4'. " +
+					"The instance is resized in place, a flavor it can't be resized to fails the plan.",
 			},
 			"name_templates": {
 				Type:          schema.TypeList,
@@ -222,6 +233,13 @@ func resourceInstance() *schema.Resource {
 							Description: "list of security group IDs",
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
+						SecurityGroupNamesField: {
+							Type:        schema.TypeSet,
+							Set:         schema.HashString,
+							Optional:    true,
+							Description: "set of security group names, they are resolved to IDs, so they must be unique in the project",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
 						"ip_address": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -339,6 +357,13 @@ from a template (marketplace), e.g. {"gitlab_external_url": "https://gitlab/..."
 				Optional:    true,
 				Description: "A boolean indicating whether to allow application ports on the instance.",
 			},
+			"ignore_external_volume_attachments": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "A boolean indicating whether to ignore the volumes attached to the instance outside of this resource. " +
+					"If set, such volumes are neither added to the 'volume' set nor detached on update, only the declared volumes are managed.",
+			},
 			"flavor": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -456,6 +481,13 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	ifs := d.Get("interface").([]interface{})
 	if len(ifs) > 0 {
+		for idx, iFace := range ifs {
+			resolved, err := resolveInterfaceSecurityGroupNames(ctx, clientV2, m, iFace.(map[string]interface{}))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			ifs[idx] = resolved
+		}
 		ifaceCreateOptsList := extractInstanceInterfaceToListCreate(ifs)
 		createOpts.Interfaces = ifaceCreateOptsList
 	}
@@ -498,7 +530,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	log.Printf("[DEBUG] Instance create options: %+v", createOpts)
 
-	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Instances.Create, &createOpts, clientV2, InstanceCreateTimeout)
+	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Instances.Create, &createOpts, clientV2, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.Errorf("error creating instance: %s", err)
 	}
@@ -546,6 +578,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
+	d.Set("region_name", instance.Region)
 	d.Set("name", instance.Name)
 	d.Set("flavor_id", instance.Flavor.FlavorID)
 	d.Set("status", instance.Status)
@@ -559,10 +592,15 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, m interfa
 	d.Set("flavor", flavor)
 
 	currentVolumes := extractVolumesIntoMap(d.Get("volume").(*schema.Set).List())
+	ignoreExternalVolumes := d.Get("ignore_external_volume_attachments").(bool)
 
 	extVolumes := make([]interface{}, 0, len(instance.Volumes))
 	for _, vol := range instance.Volumes {
 		v, ok := currentVolumes[vol.ID]
+		if !ok && ignoreExternalVolumes {
+			log.Printf("[DEBUG] Ignoring volume %s attached to instance %s outside of terraform", vol.ID, instanceID)
+			continue
+		}
 		// todo fix it
 		if !ok {
 			v = make(map[string]interface{})
@@ -597,6 +635,11 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, m interfa
 	ifs := d.Get("interface").([]interface{})
 	sort.Sort(instanceInterfaces(ifs))
 	orderedInterfacesMap := extractInstanceInterfaceToListRead(ifs)
+	orderedInterfaces := make(map[int]map[string]interface{}, len(ifs))
+	for _, iFace := range ifs {
+		iFaceMap := iFace.(map[string]interface{})
+		orderedInterfaces[iFaceMap["order"].(int)] = iFaceMap
+	}
 	var interfacesList []interface{}
 	for _, iFace := range interfacesListAPI {
 		if len(iFace.IPAssignments) == 0 {
@@ -638,11 +681,9 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, m interfa
 			}
 			i["ip_address"] = assignment.IPAddress.String()
 			if port, err := findInstancePortV2(portID, instancePorts); err == nil {
-				sgs := make([]string, len(port.SecurityGroups))
-				for i, sg := range port.SecurityGroups {
-					sgs[i] = sg.ID
-				}
-				i["security_groups"] = sgs
+				sgIDs, sgNames := splitInterfaceSecurityGroups(m, orderedInterfaces[interfaceOpts.Order], port.SecurityGroups)
+				i["security_groups"] = sgIDs
+				i[SecurityGroupNamesField] = sgNames
 			}
 
 			interfacesList = append(interfacesList, i)
@@ -734,13 +775,17 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 	if d.HasChange("flavor_id") {
 		flavorID := d.Get("flavor_id").(string)
+		instance, _, err := clientV2.Instances.Get(ctx, instanceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		result, _, err := clientV2.Instances.UpdateFlavor(ctx, instanceID, &edgecloudV2.InstanceFlavorUpdateRequest{FlavorID: flavorID})
 		if err != nil {
 			return diag.FromErr(err)
 		}
 		taskID := result.Tasks[0]
 		log.Printf("[DEBUG] Task id (%s)", taskID)
-		task, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, InstanceUpdateTimeout)
+		task, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -748,6 +793,14 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		if task.State == edgecloudV2.TaskStateError {
 			return diag.Errorf("cannot update flavor in instance with ID: %s", instanceID)
 		}
+
+		// the resized instance returns to the state it had before the resize
+		if instance.VMState == InstanceVMStateActive || instance.VMState == InstanceVMStateStopped {
+			fetch := ServerV2StateRefreshFuncV2(ctx, clientV2, instanceID)
+			if _, err := WaitForResourceStatus(ctx, fetch, []string{instance.VMState}, nil, d.Timeout(schema.TimeoutUpdate), 10*time.Second); err != nil {
+				return diag.Errorf("Error waiting for instance (%s) to become %s after resize: %s", instanceID, instance.VMState, err)
+			}
+		}
 	}
 
 	if d.HasChange("metadata") {
@@ -799,6 +852,22 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 			return diagsAdjust
 		}
 
+		// the security groups specified by name are handled by their IDs below
+		instancePorts, _, err := clientV2.Instances.PortsList(ctx, instanceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for idx, iFace := range ifsOldSlice {
+			ifsOldSlice[idx] = withAttachedInterfaceSecurityGroups(m, iFace.(map[string]interface{}), instancePorts)
+		}
+		for idx, iFace := range ifsNewSlice {
+			resolved, err := resolveInterfaceSecurityGroupNames(ctx, clientV2, m, iFace.(map[string]interface{}))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			ifsNewSlice[idx] = resolved
+		}
+
 		switch {
 		// the same number of interfaces
 		case len(ifsOldSlice) == len(ifsNewSlice):
@@ -820,7 +889,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 					}
 				}
 
-				differentFields := getMapDifference(iOld, iNew, []string{"security_groups", "port_security_disabled"})
+				differentFields := getMapDifference(iOld, iNew, []string{"security_groups", SecurityGroupNamesField, "port_security_disabled"})
 				if len(differentFields) > 0 {
 					if err := detachInterfaceFromInstanceV2(ctx, clientV2, instanceID, iOld); err != nil {
 						return diag.FromErr(err)
@@ -852,7 +921,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 					}
 				}
 
-				differentFields := getMapDifference(iOld, iNew, []string{"security_groups", "port_security_disabled"})
+				differentFields := getMapDifference(iOld, iNew, []string{"security_groups", SecurityGroupNamesField, "port_security_disabled"})
 				if len(differentFields) > 0 {
 					if err := detachInterfaceFromInstanceV2(ctx, clientV2, instanceID, iOld); err != nil {
 						return diag.FromErr(err)
@@ -891,7 +960,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 					}
 				}
 
-				differentFields := getMapDifference(iOld, iNew, []string{"security_groups", "port_security_disabled"})
+				differentFields := getMapDifference(iOld, iNew, []string{"security_groups", SecurityGroupNamesField, "port_security_disabled"})
 				if len(differentFields) > 0 {
 					if err := detachInterfaceFromInstanceV2(ctx, clientV2, instanceID, iOld); err != nil {
 						return diag.FromErr(err)
@@ -971,30 +1040,16 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, m inter
 			if _, _, err := clientV2.Instances.InstanceStart(ctx, instanceID); err != nil {
 				return diag.FromErr(err)
 			}
-			startStateConf := &retry.StateChangeConf{
-				Target:     []string{InstanceVMStateActive},
-				Refresh:    ServerV2StateRefreshFuncV2(ctx, clientV2, instanceID),
-				Timeout:    d.Timeout(schema.TimeoutCreate),
-				Delay:      10 * time.Second,
-				MinTimeout: 3 * time.Second,
-			}
-			_, err = startStateConf.WaitForStateContext(ctx)
-			if err != nil {
+			fetch := ServerV2StateRefreshFuncV2(ctx, clientV2, instanceID)
+			if _, err := WaitForResourceStatus(ctx, fetch, []string{InstanceVMStateActive}, nil, d.Timeout(schema.TimeoutUpdate), 10*time.Second); err != nil {
 				return diag.Errorf("Error waiting for instance (%s) to become active: %s", d.Id(), err)
 			}
 		case InstanceVMStateStopped:
 			if _, _, err := clientV2.Instances.InstanceStop(ctx, instanceID); err != nil {
 				return diag.FromErr(err)
 			}
-			stopStateConf := &retry.StateChangeConf{
-				Target:     []string{InstanceVMStateStopped},
-				Refresh:    ServerV2StateRefreshFuncV2(ctx, clientV2, instanceID),
-				Timeout:    d.Timeout(schema.TimeoutCreate),
-				Delay:      10 * time.Second,
-				MinTimeout: 3 * time.Second,
-			}
-			_, err = stopStateConf.WaitForStateContext(ctx)
-			if err != nil {
+			fetch := ServerV2StateRefreshFuncV2(ctx, clientV2, instanceID)
+			if _, err := WaitForResourceStatus(ctx, fetch, []string{InstanceVMStateStopped}, nil, d.Timeout(schema.TimeoutUpdate), 10*time.Second); err != nil {
 				return diag.Errorf("Error waiting for instance (%s) to become inactive(stopped): %s", d.Id(), err)
 			}
 		}
@@ -1018,14 +1073,14 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, m inter
 	instanceID := d.Id()
 	log.Printf("[DEBUG] Instance id = %s", instanceID)
 
-	var delOpts edgecloudV2.InstanceDeleteOptions
-	results, _, err := clientV2.Instances.Delete(ctx, instanceID, &delOpts)
+	delOpts := instanceDeleteOptions(m.(*Config), d.Get("interface").([]interface{}), "port_id")
+	results, _, err := clientV2.Instances.Delete(ctx, instanceID, delOpts)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	taskID := results.Tasks[0]
 	log.Printf("[DEBUG] Task id (%s)", taskID)
-	task, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, InstanceDeleteTimeout)
+	task, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -1039,3 +1094,32 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, m inter
 
 	return diags
 }
+
+// resourceInstanceCustomizeDiff fails the plan when the instance can't be resized to the new flavor,
+// otherwise the flavor is changed in place.
+func resourceInstanceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("flavor_id") || !d.NewValueKnown("flavor_id") {
+		return nil
+	}
+
+	clientV2, err := instanceDiffCloudClient(ctx, d, m)
+	if err != nil {
+		return err
+	}
+
+	flavors, _, err := clientV2.Instances.AvailableFlavorsToResize(ctx, d.Id(), nil)
+	if err != nil {
+		log.Printf("[WARN] Cannot get the flavors to resize instance (%s) into, the flavor is changed in place: %s", d.Id(), err)
+		return nil
+	}
+	flavorID := d.Get("flavor_id").(string)
+	available := make([]string, 0, len(flavors))
+	for _, flavor := range flavors {
+		if flavor.FlavorID == flavorID {
+			return nil
+		}
+		available = append(available, flavor.FlavorID)
+	}
+
+	return fmt.Errorf("instance %s can't be resized to flavor %s, available flavors are: %s", d.Id(), flavorID, strings.Join(available, ", "))
+}
@@ -3,6 +3,7 @@ package edgecenter
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -12,8 +13,8 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -41,15 +42,51 @@ const (
 	InstanceUserDataField              = "user_data"
 	InstanceAllowAppPortsField         = "allow_app_ports"
 	InstanceReservedFixedIPPortIDField = "reserved_fixed_ip_port_id"
+	InstanceSGsPerInterfaceField       = "security_groups_per_interface"
+	InstanceFirstIPv4AddressField      = "first_ipv4_address"
+	InstanceFirstIPv6AddressField      = "first_ipv6_address"
+	InstanceDetachBeforeDeleteField    = "detach_before_delete"
+	InstanceShutdownTimeoutField       = "graceful_shutdown_timeout"
+	InstanceAllowStoppedUpdateField    = "allow_stopped_update"
+	InstanceStoppedUpdateWindowField   = "stopped_update_window"
+	InstanceHostnameField              = "hostname"
+	InstanceFixedIPAddressField        = "fixed_ip_address"
+	InstanceVolumeDeviceField          = "device"
+	InstancePlacementFallbackField     = "placement_fallback_policy"
+	InstancePlacementFallbackUsedField = "placement_fallback_applied"
 )
 
+const (
+	InstancePlacementFallbackNone             = "none"
+	InstancePlacementFallbackSoftAntiAffinity = "soft-anti-affinity"
+)
+
+// instanceV2CreateOnlyFields are applied by the API only when the instance is created.
+// Changing them neither updates the instance nor replaces it.
+var instanceV2CreateOnlyFields = []string{
+	InstanceNameTemplateField,
+	InstanceKeypairNameField,
+	PasswordField,
+	UsernameField,
+	InstanceConfigurationField,
+	InstanceUserDataField,
+	InstanceAllowAppPortsField,
+	InstancePlacementFallbackField,
+}
+
 func resourceInstanceV2() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceInstanceCreateV2,
 		ReadContext:   resourceInstanceReadV2,
 		UpdateContext: resourceInstanceUpdateV2,
 		DeleteContext: resourceInstanceDeleteV2,
+		CustomizeDiff: validateInterfaceFixedIPAddresses,
 		Description:   "A cloud instance is a virtual machine in a cloud environment.",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(InstanceCreateTimeout),
+			Update: schema.DefaultTimeout(InstanceUpdateTimeout),
+			Delete: schema.DefaultTimeout(InstanceDeleteTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				projectID, regionID, InstanceID, err := ImportStringParser(d.Id())
@@ -87,11 +124,12 @@ func resourceInstanceV2() *schema.Resource {
 				ExactlyOneOf: []string{RegionIDField, RegionNameField},
 			},
 			RegionNameField: {
-				Type:         schema.TypeString,
-				Computed:     true,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{RegionIDField, RegionNameField},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			NameField: {
 				Type:        schema.TypeString,
@@ -147,6 +185,11 @@ func resourceInstanceV2() *schema.Resource {
 							Default:     "",
 							Description: "The block device attachment tag (exposed in the metadata).",
 						},
+						InstanceVolumeDeviceField: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The device path of the volume in the instance, e.g. '/dev/vdb'.",
+						},
 					},
 				},
 			},
@@ -182,6 +225,11 @@ func resourceInstanceV2() *schema.Resource {
 							Default:     "",
 							Description: "The block device attachment tag (exposed in the metadata).",
 						},
+						InstanceVolumeDeviceField: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The device path of the volume in the instance, e.g. '/dev/vdb'.",
+						},
 					},
 				},
 			},
@@ -219,6 +267,11 @@ interfaces. You must always have exactly one interface with set attribute 'is_de
 							Description: "Name of the network.",
 							Computed:    true,
 						},
+						PortMacAddressField: {
+							Type:        schema.TypeString,
+							Description: "The MAC address of the port of the interface. It is assigned by the cloud and cannot be changed.",
+							Computed:    true,
+						},
 						SubnetIDField: {
 							Type:         schema.TypeString,
 							Description:  "Required if type is 'subnet'.",
@@ -233,6 +286,15 @@ interfaces. You must always have exactly one interface with set attribute 'is_de
 							Optional:     true,
 							ValidateFunc: validation.IsUUID,
 						},
+						InstanceFixedIPAddressField: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+							Description: "The exact IP address requested for the interface instead of the one assigned by DHCP. " +
+								"Only for the interfaces of type 'subnet', must be inside the CIDR of the subnet. " +
+								"The address is reserved as a port, which is deleted together with the interface.",
+							ValidateFunc: validation.IsIPAddress,
+						},
 						IPAddressField: {
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -248,13 +310,27 @@ interfaces. You must always have exactly one interface with set attribute 'is_de
 			InstanceKeypairNameField: {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The name of the key pair to be associated with the instance for SSH access.",
+				Description: "The name of the key pair to be associated with the instance for SSH access. Applied only when the instance is created.",
 			},
 			InstanceServerGroupField: {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "The ID (uuid) of the server group to which the instance should belong.",
 			},
+			InstancePlacementFallbackField: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  InstancePlacementFallbackNone,
+				Description: fmt.Sprintf(`What to do when the instance can't be placed in the anti-affinity server group, because every host already runs a member of the group.
+With %q the creation fails. With %q the instance is created outside the server group, so the scheduler may place it next to the group members, and a warning is shown. Applied only when the instance is created.`,
+					InstancePlacementFallbackNone, InstancePlacementFallbackSoftAntiAffinity),
+				ValidateFunc: validation.StringInSlice([]string{InstancePlacementFallbackNone, InstancePlacementFallbackSoftAntiAffinity}, false),
+			},
+			InstancePlacementFallbackUsedField: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the instance was created outside its server group because of the placement fallback policy.",
+			},
 			PasswordField: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -275,6 +351,13 @@ interfaces. You must always have exactly one interface with set attribute 'is_de
 					Type: schema.TypeString,
 				},
 			},
+			InstanceHostnameField: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The hostname or FQDN of the instance, distinct from its name. It is stored in the instance metadata " +
+					"under the 'hostname' key and changed in place. If 'user_data' is not set, it is also applied by cloud-init " +
+					"when the instance is created.",
+			},
 			InstanceConfigurationField: {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -296,18 +379,83 @@ from a template (marketplace), e.g. {"gitlab_external_url": "https://gitlab/..."
 			InstanceUserDataField: {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "A field for specifying user data to be used for configuring the instance at launch time.",
+				Description: "A field for specifying user data to be used for configuring the instance at launch time. Applied only when the instance is created.",
 			},
 			InstanceAllowAppPortsField: {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Description: "A boolean indicating whether to allow application ports on the instance.",
 			},
+			InstanceDetachBeforeDeleteField: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "A boolean indicating whether to detach floating IPs and data volumes before the instance is deleted, " +
+					"so they are kept and can be reused.",
+			},
+			InstanceShutdownTimeoutField: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "The number of seconds to wait for the instance to stop before it is deleted. " +
+					"If set, the instance is stopped first, so the workloads on it can shut down gracefully. " +
+					"The instance is deleted anyway when the timeout expires. By default, the instance is deleted without stopping.",
+			},
+			InstanceAllowStoppedUpdateField: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "A boolean indicating whether the instance may be stopped to change its flavor. " +
+					"If set, the active instance is stopped, the flavor is changed and the instance is started again.",
+			},
+			InstanceStoppedUpdateWindowField: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{InstanceAllowStoppedUpdateField},
+				ValidateFunc: validateMaintenanceWindow,
+				Description: "The time window in UTC in the format 'HH:MM-HH:MM' when the instance may be stopped to be updated, " +
+					"for example '22:00-02:00'. Outside the window, updates that require stopping the instance fail. " +
+					"By default, the instance may be stopped at any time.",
+			},
 			FlavorField: {
 				Type:        schema.TypeMap,
 				Computed:    true,
 				Description: `A map defining the flavor of the instance, for example, {"flavor_name": "g1-standard-2-4", "ram": 4096, ...}.`,
 			},
+			InstanceFirstIPv4AddressField: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The first IPv4 address of the instance interfaces, e.g. to be used as an lbmember address.",
+			},
+			InstanceFirstIPv6AddressField: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The first IPv6 address of the instance interfaces.",
+			},
+			InstanceSGsPerInterfaceField: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Actual security groups of each instance port, including groups assigned outside of Terraform.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						PortIDField: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						SecurityGroupIDsField: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						SecurityGroupNamesField: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			StatusField: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -319,8 +467,9 @@ from a template (marketplace), e.g. {"gitlab_external_url": "https://gitlab/..."
 				Optional: true,
 				Computed: true,
 				Description: fmt.Sprintf(`The current virtual machine state of the instance, 
-allowing you to start or stop the VM. Possible values are %s and %s.`, InstanceVMStateStopped, InstanceVMStateActive),
-				ValidateFunc: validation.StringInSlice([]string{InstanceVMStateActive, InstanceVMStateStopped}, true),
+allowing you to start, stop or shelve the VM. Possible values are %s, %s and %s. 
+The shelved instance releases its compute resources, but keeps its volumes and addresses.`, InstanceVMStateStopped, InstanceVMStateActive, InstanceVMStateShelved),
+				ValidateFunc: validation.StringInSlice([]string{InstanceVMStateActive, InstanceVMStateStopped, InstanceVMStateShelved}, true),
 			},
 		},
 	}
@@ -351,11 +500,13 @@ func resourceInstanceCreateV2(ctx context.Context, d *schema.ResourceData, m int
 
 	if userData, ok := d.GetOk(InstanceUserDataField); ok {
 		createOpts.UserData = base64.StdEncoding.EncodeToString([]byte(userData.(string)))
+	} else if hostname, ok := d.GetOk(InstanceHostnameField); ok {
+		createOpts.UserData = base64.StdEncoding.EncodeToString([]byte(hostnameCloudConfig(hostname.(string))))
 	}
 
 	name := d.Get(NameField).(string)
 	if len(name) > 0 {
-		createOpts.Names = []string{name}
+		createOpts.Names = []string{withNamePrefix(m, name)}
 	}
 
 	if nameTemplate, ok := d.GetOk("name_template"); ok {
@@ -379,16 +530,18 @@ func resourceInstanceCreateV2(ctx context.Context, d *schema.ResourceData, m int
 		createOpts.Volumes = append(createOpts.Volumes, vs...)
 	}
 
+	var fixedIPPortIDs []string
 	ifsRaw := d.Get(InstanceInterfacesField)
 	ifsSet := ifsRaw.(*schema.Set)
 	ifs := ifsSet.List()
 	if len(ifs) > 0 {
 		sort.Sort(instanceV2Interfaces(ifs))
-		ifaceCreateOptsList, err := prepareInstanceInterfaceCreateOpts(ctx, clientV2, ifs)
+		ifaceCreateOptsList, reservedPortIDs, err := prepareInstanceInterfaceCreateOpts(ctx, clientV2, ifs)
 		if err != nil {
-			return diag.FromErr(err)
+			return diag.FromErr(errors.Join(err, releaseInterfaceFixedIPAddresses(ctx, clientV2, reservedPortIDs)))
 		}
 		createOpts.Interfaces = ifaceCreateOptsList
+		fixedIPPortIDs = reservedPortIDs
 	}
 
 	if metadataRaw, ok := d.GetOk(MetadataField); ok {
@@ -398,6 +551,13 @@ func resourceInstanceCreateV2(ctx context.Context, d *schema.ResourceData, m int
 		}
 		createOpts.Metadata = *metadata
 	}
+	if hostname, ok := d.GetOk(InstanceHostnameField); ok {
+		if createOpts.Metadata == nil {
+			createOpts.Metadata = make(edgecloudV2.Metadata)
+		}
+		createOpts.Metadata[InstanceHostnameField] = hostname.(string)
+	}
+	createOpts.Metadata = withMetadataStamp(m, createOpts.Metadata)
 
 	configuration := d.Get(InstanceConfigurationField)
 	if len(configuration.([]interface{})) > 0 {
@@ -410,14 +570,49 @@ func resourceInstanceCreateV2(ctx context.Context, d *schema.ResourceData, m int
 
 	log.Printf("[DEBUG] Instance create options: %+v", createOpts)
 
-	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Instances.Create, &createOpts, clientV2, InstanceCreateTimeout)
+	taskResult, failedInstanceIDs, err := createInstanceV2(ctx, clientV2, &createOpts, d.Timeout(schema.TimeoutCreate))
+	fallbackApplied := false
+	if err != nil && isInstancePlacementError(err) && d.Get(InstancePlacementFallbackField).(string) == InstancePlacementFallbackSoftAntiAffinity {
+		fallback, lookupErr := isAntiAffinityServerGroup(ctx, clientV2, createOpts.ServerGroupID)
+		if lookupErr != nil {
+			log.Printf("[WARN] Cannot check the placement fallback: %s", lookupErr)
+		}
+		if lookupErr == nil && fallback {
+			// the failed create leaves the instance in the ERROR state, it is deleted before the retry
+			if deleteErr := deleteInstancesV2(ctx, clientV2, failedInstanceIDs, d.Timeout(schema.TimeoutCreate)); deleteErr != nil {
+				return diag.Errorf("error from creating instance: %s; cannot delete the failed instance: %s", err, deleteErr)
+			}
+
+			log.Printf("[WARN] Instance can't be placed in server group %s, creating it outside the group", createOpts.ServerGroupID)
+			serverGroupID := createOpts.ServerGroupID
+			createOpts.ServerGroupID = ""
+			fallbackResult, _, fallbackErr := createInstanceV2(ctx, clientV2, &createOpts, d.Timeout(schema.TimeoutCreate))
+			if fallbackErr != nil {
+				err = fmt.Errorf("%w; creating it outside the server group failed too: %w", err, fallbackErr)
+			} else {
+				taskResult, err = fallbackResult, nil
+				fallbackApplied = true
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Instance is created outside its server group",
+					Detail: fmt.Sprintf("The instance can't be placed in the anti-affinity server group %s, so it is created outside the group "+
+						"according to %q and may share a host with the group members.", serverGroupID, InstancePlacementFallbackField),
+					AttributePath: cty.GetAttrPath(InstanceServerGroupField),
+				})
+			}
+		}
+	}
 	if err != nil {
+		if releaseErr := releaseInterfaceFixedIPAddresses(ctx, clientV2, fixedIPPortIDs); releaseErr != nil {
+			log.Printf("[WARN] %s", releaseErr)
+		}
 		return diag.Errorf("error from creating instance: %s", err)
 	}
 
 	instanceID := taskResult.Instances[0]
 	log.Printf("[DEBUG] Instance id (%s)", instanceID)
 	d.SetId(instanceID)
+	d.Set(InstancePlacementFallbackUsedField, fallbackApplied)
 
 	resourceInstanceReadV2(ctx, d, m)
 
@@ -450,10 +645,11 @@ func resourceInstanceReadV2(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	d.Set(NameField, instance.Name)
+	d.Set(RegionNameField, instance.Region)
+	d.Set(NameField, withoutNamePrefix(m, instance.Name))
 	d.Set(FlavorIDField, instance.Flavor.FlavorID)
 	d.Set(StatusField, instance.Status)
-	d.Set(InstanceVMStateField, instance.VMState)
+	d.Set(InstanceVMStateField, instanceVMState(instance.VMState))
 
 	flavor := make(map[string]interface{}, 4)
 	flavor[FlavorIDField] = instance.Flavor.FlavorID
@@ -472,14 +668,14 @@ func resourceInstanceReadV2(ctx context.Context, d *schema.ResourceData, m inter
 
 	bootVolumesSet := d.Get(InstanceBootVolumesField).(*schema.Set)
 	bootVolumesState := extractVolumesIntoMap(bootVolumesSet.List())
-	enrichedBootVolumesData := EnrichVolumeData(instanceVolumes, bootVolumesState)
+	enrichedBootVolumesData := EnrichVolumeData(instanceID, instanceVolumes, bootVolumesState)
 	if err := d.Set(InstanceBootVolumesField, schema.NewSet(bootVolumesSet.F, enrichedBootVolumesData)); err != nil {
 		return diag.FromErr(err)
 	}
 
 	dataVolumesSet := d.Get(InstanceDataVolumesField).(*schema.Set)
 	dataVolumesState := extractVolumesIntoMap(dataVolumesSet.List())
-	enrichedDataVolumesData := EnrichVolumeData(instanceVolumes, dataVolumesState)
+	enrichedDataVolumesData := EnrichVolumeData(instanceID, instanceVolumes, dataVolumesState)
 	if err := d.Set(InstanceDataVolumesField, schema.NewSet(dataVolumesSet.F, enrichedDataVolumesData)); err != nil {
 		return diag.FromErr(err)
 	}
@@ -520,6 +716,7 @@ func resourceInstanceReadV2(ctx context.Context, d *schema.ResourceData, m inter
 			}
 			interfaceOptsMap[IPAddressField] = assignment.IPAddress.String()
 			interfaceOptsMap[NetworkNameField] = iFace.NetworkDetails.Name
+			interfaceOptsMap[PortMacAddressField] = iFace.MacAddress
 			interfaceOptsMap[PortIDField] = iFace.PortID
 			interfacesOptsList = append(interfacesOptsList, interfaceOptsMap)
 		}
@@ -529,6 +726,32 @@ func resourceInstanceReadV2(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
+	firstIPv4, firstIPv6 := instanceFirstIPAddresses(interfacesListAPI)
+	d.Set(InstanceFirstIPv4AddressField, firstIPv4)
+	d.Set(InstanceFirstIPv6AddressField, firstIPv6)
+
+	instancePorts, _, err := clientV2.Instances.PortsList(ctx, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sgsPerInterface := make([]map[string]interface{}, 0, len(instancePorts))
+	for _, port := range instancePorts {
+		sgIDs := make([]string, 0, len(port.SecurityGroups))
+		sgNames := make([]string, 0, len(port.SecurityGroups))
+		for _, sg := range port.SecurityGroups {
+			sgIDs = append(sgIDs, sg.ID)
+			sgNames = append(sgNames, sg.Name)
+		}
+		sgsPerInterface = append(sgsPerInterface, map[string]interface{}{
+			PortIDField:             port.ID,
+			SecurityGroupIDsField:   sgIDs,
+			SecurityGroupNamesField: sgNames,
+		})
+	}
+	if err := d.Set(InstanceSGsPerInterfaceField, sgsPerInterface); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if metadataRaw, ok := d.GetOk(MetadataField); ok {
 		metadata := metadataRaw.(map[string]interface{})
 		newMetadata := make(map[string]interface{}, len(metadata))
@@ -544,6 +767,17 @@ func resourceInstanceReadV2(ctx context.Context, d *schema.ResourceData, m inter
 		}
 	}
 
+	// the hostname is read regardless of the configuration, so that it is imported as well
+	md, resp, err := clientV2.Instances.MetadataGetItem(ctx, instanceID, &edgecloudV2.MetadataItemOptions{Key: InstanceHostnameField})
+	switch {
+	case resp != nil && resp.StatusCode == http.StatusNotFound:
+		d.Set(InstanceHostnameField, "")
+	case err != nil:
+		return diag.Errorf("cannot get hostname of instance %s: %s", instanceID, err)
+	default:
+		d.Set(InstanceHostnameField, md.Value)
+	}
+
 	log.Println("[DEBUG] Finish Instance reading")
 
 	return diags
@@ -564,41 +798,65 @@ func resourceInstanceUpdateV2(ctx context.Context, d *schema.ResourceData, m int
 		return diags
 	}
 
+	for _, field := range instanceV2CreateOnlyFields {
+		if d.HasChange(field) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("%q can't be updated for existing instance", field),
+				Detail: fmt.Sprintf("The %q value is applied only when the instance is created, "+
+					"the instance %s keeps the previous value. Recreate the instance to apply it.", field, instanceID),
+				AttributePath: cty.GetAttrPath(field),
+			})
+		}
+	}
+
 	if d.HasChange(NameField) {
 		nameTemplate := d.Get(InstanceNameTemplateField).(string)
 		if len(nameTemplate) == 0 {
-			opts := edgecloudV2.Name{Name: d.Get(NameField).(string)}
+			opts := edgecloudV2.Name{Name: withNamePrefix(m, d.Get(NameField).(string))}
 			if _, _, err := clientV2.Instances.Rename(ctx, instanceID, &opts); err != nil {
 				return diag.FromErr(err)
 			}
 		}
 	}
 
+	var stoppedForUpdate bool
 	if d.HasChange(FlavorIDField) {
-		flavorID := d.Get(FlavorIDField).(string)
-		result, _, err := clientV2.Instances.UpdateFlavor(ctx, instanceID, &edgecloudV2.InstanceFlavorUpdateRequest{FlavorID: flavorID})
+		stoppedForUpdate, err = stopInstanceForUpdate(ctx, clientV2, d, time.Now())
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		taskID := result.Tasks[0]
-		log.Printf("[DEBUG] Task id (%s)", taskID)
-		task, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, InstanceUpdateTimeout)
-		if err != nil {
+
+		flavorID := d.Get(FlavorIDField).(string)
+		if err := updateInstanceFlavorV2(ctx, clientV2, instanceID, flavorID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			// the instance must not be left stopped because of a failed update
+			if stoppedForUpdate {
+				if startErr := startInstanceAfterUpdate(ctx, clientV2, instanceID, d.Timeout(schema.TimeoutUpdate)); startErr != nil {
+					log.Printf("[WARN] Cannot start instance (%s) after the failed flavor update: %s", instanceID, startErr)
+				}
+			}
 			return diag.FromErr(err)
 		}
 
-		if task.State == edgecloudV2.TaskStateError {
-			return diag.Errorf("cannot update flavor in instance with ID: %s", instanceID)
+		if stoppedForUpdate && !slices.Contains([]string{InstanceVMStateStopped, InstanceVMStateShelved}, d.Get(InstanceVMStateField).(string)) {
+			if err := startInstanceAfterUpdate(ctx, clientV2, instanceID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	}
 
-	if d.HasChange(MetadataField) {
+	if d.HasChanges(MetadataField, InstanceHostnameField) {
 		omd, nmd := d.GetChange(MetadataField)
-		if !reflect.DeepEqual(omd, nmd) {
+		if !reflect.DeepEqual(omd, nmd) || d.HasChange(InstanceHostnameField) {
 			MetaData := make(edgecloudV2.Metadata)
 			for k, v := range nmd.(map[string]interface{}) {
 				MetaData[k] = v.(string)
 			}
+			// the metadata is replaced as a whole, so the stamp and the hostname are sent with it
+			MetaData = withMetadataStamp(m, MetaData)
+			if hostname := d.Get(InstanceHostnameField).(string); hostname != "" {
+				MetaData[InstanceHostnameField] = hostname
+			}
 			_, err = clientV2.Instances.MetadataCreate(ctx, instanceID, &MetaData)
 			if err != nil {
 				return diag.Errorf("cannot create metadata. Error: %s", err)
@@ -698,6 +956,9 @@ func resourceInstanceUpdateV2(ctx context.Context, d *schema.ResourceData, m int
 			if err := detachInterfaceFromInstanceV2(ctx, clientV2, instanceID, detachIfs); err != nil {
 				return diag.FromErr(err)
 			}
+			if err := releaseInterfaceFixedIPAddresses(ctx, clientV2, interfaceFixedIPAddressPortIDs([]interface{}{detachIfs})); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 
 		if len(ifsToAttachList) > 0 {
@@ -712,6 +973,26 @@ func resourceInstanceUpdateV2(ctx context.Context, d *schema.ResourceData, m int
 				}
 			}
 		}
+
+		if len(ifsToDetachList) > 0 || len(ifsToAttachList) > 0 {
+			// the reattached reserved fixed ip ports come back with the same ID
+			reattachedPortIDs := make(map[string]struct{}, len(ifsToAttachList))
+			for _, item := range ifsToAttachList {
+				if portID := item.(map[string]interface{})[InstanceReservedFixedIPPortIDField].(string); portID != "" {
+					reattachedPortIDs[portID] = struct{}{}
+				}
+			}
+			detachedPortIDs := make([]string, 0, len(ifsToDetachList))
+			for _, item := range ifsToDetachList {
+				portID := item.(map[string]interface{})[PortIDField].(string)
+				if _, ok := reattachedPortIDs[portID]; !ok && portID != "" {
+					detachedPortIDs = append(detachedPortIDs, portID)
+				}
+			}
+			if err := waitForInstanceInterfacesStable(ctx, clientV2, instanceID, detachedPortIDs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	if d.HasChange(InstanceServerGroupField) {
@@ -751,43 +1032,46 @@ func resourceInstanceUpdateV2(ctx context.Context, d *schema.ResourceData, m int
 	}
 
 	if d.HasChange(InstanceVMStateField) {
+		oldState, _ := d.GetChange(InstanceVMStateField)
 		state := d.Get(InstanceVMStateField).(string)
+		if oldState.(string) == InstanceVMStateShelved {
+			// the unshelved instance becomes active, so it has only to be stopped if needed
+			if err := unshelveInstance(ctx, clientV2, instanceID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 		switch state {
 		case InstanceVMStateActive:
+			if oldState.(string) == InstanceVMStateShelved {
+				break
+			}
 			if _, _, err := clientV2.Instances.InstanceStart(ctx, instanceID); err != nil {
 				return diag.FromErr(err)
 			}
-			startStateConf := &retry.StateChangeConf{
-				Target:     []string{InstanceVMStateActive},
-				Refresh:    ServerV2StateRefreshFuncV2(ctx, clientV2, instanceID),
-				Timeout:    d.Timeout(schema.TimeoutCreate),
-				Delay:      10 * time.Second,
-				MinTimeout: 3 * time.Second,
-			}
-			_, err = startStateConf.WaitForStateContext(ctx)
-			if err != nil {
+			fetch := ServerV2StateRefreshFuncV2(ctx, clientV2, instanceID)
+			if _, err := WaitForResourceStatus(ctx, fetch, []string{InstanceVMStateActive}, nil, d.Timeout(schema.TimeoutUpdate), 10*time.Second); err != nil {
 				return diag.Errorf("Error waiting for instance (%s) to become active: %s", d.Id(), err)
 			}
 		case InstanceVMStateStopped:
+			if stoppedForUpdate {
+				break
+			}
 			if _, _, err := clientV2.Instances.InstanceStop(ctx, instanceID); err != nil {
 				return diag.FromErr(err)
 			}
-			stopStateConf := &retry.StateChangeConf{
-				Target:     []string{InstanceVMStateStopped},
-				Refresh:    ServerV2StateRefreshFuncV2(ctx, clientV2, instanceID),
-				Timeout:    d.Timeout(schema.TimeoutCreate),
-				Delay:      10 * time.Second,
-				MinTimeout: 3 * time.Second,
-			}
-			_, err = stopStateConf.WaitForStateContext(ctx)
-			if err != nil {
+			fetch := ServerV2StateRefreshFuncV2(ctx, clientV2, instanceID)
+			if _, err := WaitForResourceStatus(ctx, fetch, []string{InstanceVMStateStopped}, nil, d.Timeout(schema.TimeoutUpdate), 10*time.Second); err != nil {
 				return diag.Errorf("Error waiting for instance (%s) to become inactive(stopped): %s", d.Id(), err)
 			}
+		case InstanceVMStateShelved:
+			if err := shelveInstance(ctx, clientV2, instanceID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	}
 	log.Println("[DEBUG] Finish Instance updating")
 
-	return resourceInstanceReadV2(ctx, d, m)
+	return append(diags, resourceInstanceReadV2(ctx, d, m)...)
 }
 
 func resourceInstanceDeleteV2(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -802,14 +1086,34 @@ func resourceInstanceDeleteV2(ctx context.Context, d *schema.ResourceData, m int
 	instanceID := d.Id()
 	log.Printf("[DEBUG] Instance id = %s", instanceID)
 
-	var delOpts edgecloudV2.InstanceDeleteOptions
-	results, _, err := clientV2.Instances.Delete(ctx, instanceID, &delOpts)
+	if shutdownTimeout := d.Get(InstanceShutdownTimeoutField).(int); shutdownTimeout > 0 {
+		if err := shutdownInstanceBeforeDelete(ctx, clientV2, instanceID, time.Duration(shutdownTimeout)*time.Second); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	delOpts := instanceDeleteOptions(m.(*Config), d.Get(InstanceInterfacesField).(*schema.Set).List(), InstanceReservedFixedIPPortIDField)
+	if d.Get(InstanceDetachBeforeDeleteField).(bool) {
+		dataVolumes := extractInstanceVolumesMap(d.Get(InstanceDataVolumesField).(*schema.Set).List())
+		volumeIDs := make([]string, 0, len(dataVolumes))
+		for volumeID := range dataVolumes {
+			volumeIDs = append(volumeIDs, volumeID)
+		}
+		if err := detachInstanceFloatingIPsAndVolumes(ctx, clientV2, instanceID, volumeIDs, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+		delOpts.DeleteFloatings = false
+		delOpts.ReservedFixedIPs = nil
+	}
+	// the ports reserved for the fixed IP addresses of the interfaces are managed by the instance
+	delOpts.ReservedFixedIPs = append(delOpts.ReservedFixedIPs, interfaceFixedIPAddressPortIDs(d.Get(InstanceInterfacesField).(*schema.Set).List())...)
+	results, _, err := clientV2.Instances.Delete(ctx, instanceID, delOpts)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	taskID := results.Tasks[0]
 	log.Printf("[DEBUG] Task id (%s)", taskID)
-	task, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, InstanceDeleteTimeout)
+	task, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
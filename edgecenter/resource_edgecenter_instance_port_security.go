@@ -2,6 +2,7 @@ package edgecenter
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -18,6 +19,13 @@ const (
 	InstancePortSecurityReadTimeout   = 1200 * time.Second
 	InstancePortSecurityDeleteTimeout = 1200 * time.Second
 	InstancePortSecurityUpdateTimeout = 1200 * time.Second
+
+	// PortSecurityPolicyStrict removes the security groups that are not specified in the resource.
+	PortSecurityPolicyStrict = "strict"
+	// PortSecurityPolicyAdditive only assigns the specified security groups and never removes any.
+	PortSecurityPolicyAdditive = "additive"
+	// PortSecurityPolicyIgnoreExternal manages the specified security groups and never touches the others.
+	PortSecurityPolicyIgnoreExternal = "ignore_external"
 )
 
 func resourceInstancePortSecurity() *schema.Resource {
@@ -26,6 +34,18 @@ func resourceInstancePortSecurity() *schema.Resource {
 		ReadContext:   resourceInstancePortSecurityRead,
 		UpdateContext: resourceInstancePortSecurityUpdate,
 		DeleteContext: resourceInstancePortSecurityDelete,
+		CustomizeDiff: validatePortSecAttrs,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceInstancePortSecurityImport,
+		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceInstancePortSecurityV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceInstancePortSecurityStateUpgradeV0,
+			},
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(InstancePortSecurityCreateTimeout),
 			Read:   schema.DefaultTimeout(InstancePortSecurityReadTimeout),
@@ -51,16 +71,19 @@ func resourceInstancePortSecurity() *schema.Resource {
 			RegionIDField: {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
 				ExactlyOneOf: []string{RegionIDField, RegionNameField},
 			},
 			RegionNameField: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{RegionIDField, RegionNameField},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 
 			InstanceIDField: {
@@ -102,6 +125,20 @@ func resourceInstancePortSecurity() *schema.Resource {
 							Computed:    true,
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
+						SecurityGroupNamesField: {
+							Type:        schema.TypeSet,
+							Set:         schema.HashString,
+							Description: "A set of security groups names that need to be attached. The names are resolved to IDs, so they must be unique in the project.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						NamedSecurityGroupIDsField: {
+							Type:        schema.TypeSet,
+							Set:         schema.HashString,
+							Description: "A set of IDs of the security groups that are attached by name. They are detached by these IDs, even if the security groups have been renamed since.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
 						AllSecurityGroupIDsField: {
 							Type: schema.TypeSet,
 							Set:  schema.HashString,
@@ -111,13 +148,25 @@ func resourceInstancePortSecurity() *schema.Resource {
 							Computed: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
-						OverwriteExistingField: {
-							Type: schema.TypeBool,
-							Description: "Whether to overwrite all security groups. If this field has value \"true\", " +
-								"security groups that were created outside of this resource (the default security group " +
-								"and security groups created through UI or API will be deleted and attached security groups specified in the attribute \"security_group_ids\" only)",
+						ManagementPolicyField: {
+							Type: schema.TypeString,
+							Description: fmt.Sprintf("How the security groups of the port are managed. "+
+								"With %q, the security groups that are not specified in this resource (the default security group "+
+								"and security groups attached through the UI or API) are removed. "+
+								"With %q, the specified security groups are only assigned and never removed, even when the resource is deleted. "+
+								"With %q, the specified security groups are assigned and removed, the others are never touched.",
+								PortSecurityPolicyStrict, PortSecurityPolicyAdditive, PortSecurityPolicyIgnoreExternal),
 							Optional: true,
-							Default:  false,
+							Default:  PortSecurityPolicyIgnoreExternal,
+							ValidateFunc: validation.StringInSlice([]string{
+								PortSecurityPolicyStrict, PortSecurityPolicyAdditive, PortSecurityPolicyIgnoreExternal,
+							}, false),
+						},
+						OverwriteExistingField: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Deprecated:  fmt.Sprintf("Use %s = %q instead.", ManagementPolicyField, PortSecurityPolicyStrict),
+							Description: fmt.Sprintf("If true, the %q management policy is used.", PortSecurityPolicyStrict),
 						},
 					},
 				},
@@ -137,10 +186,6 @@ func resourceInstancePortSecurityCreate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	diags := validatePortSecAttrs(d)
-	if diags.HasError() {
-		return diags
-	}
 	portID := d.Get(PortIDField).(string)
 	instanceID := d.Get(InstanceIDField).(string)
 
@@ -175,31 +220,33 @@ func resourceInstancePortSecurityCreate(ctx context.Context, d *schema.ResourceD
 	case 0:
 	default:
 		sgsMap := sgsList[0].(map[string]interface{})
-		sgsIDsRaw, sgsIDsOK := sgsMap[SecurityGroupIDsField]
-		enforce := sgsMap[OverwriteExistingField].(bool)
-		if enforce && sgsIDsOK {
-			var sgsToRemove []interface{}
-
+		sgsIDsSet, err := portSecurityGroupIDs(ctx, clientV2, m, sgsMap)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		var sgsToRemove []interface{}
+		if portSecurityPolicy(sgsMap) == PortSecurityPolicyStrict {
 			instancePort, err := utilV2.InstanceNetworkPortByID(ctx, clientV2, instanceID, portID)
 			if err != nil {
 				return diag.FromErr(err)
 			}
-			if len(instancePort.SecurityGroups) != 0 {
-				for _, sg := range instancePort.SecurityGroups {
+			for _, sg := range instancePort.SecurityGroups {
+				if !sgsIDsSet.Contains(sg.ID) {
 					sgsToRemove = append(sgsToRemove, sg.ID)
 				}
-				err = removeSecurityGroupsFromInstancePort(ctx, clientV2, instanceID, portID, sgsToRemove)
-				if err != nil {
-					return diag.FromErr(err)
-				}
 			}
 		}
-		sgsIDsSet := sgsIDsRaw.(*schema.Set)
 		sgsIDsList := sgsIDsSet.List()
 		err = AssignSecurityGroupsToInstancePort(ctx, clientV2, instanceID, portID, sgsIDsList)
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		// The existing security groups are removed after the new ones are assigned,
+		// so the port is never left without security groups.
+		err = removeSecurityGroupsFromInstancePort(ctx, clientV2, instanceID, portID, sgsToRemove)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	d.SetId(portID)
@@ -247,8 +294,8 @@ func resourceInstancePortSecurityRead(ctx context.Context, d *schema.ResourceDat
 	sgsMap := make(map[string]interface{}, 3)
 
 	sgsMapState := sgsListState[0].(map[string]interface{})
-	enforce := sgsMapState[OverwriteExistingField].(bool)
-	sgsMap[OverwriteExistingField] = enforce
+	sgsMap[ManagementPolicyField] = sgsMapState[ManagementPolicyField]
+	sgsMap[OverwriteExistingField] = sgsMapState[OverwriteExistingField]
 
 	sgIDsRaw, sgIDsRawOk := sgsMapState[SecurityGroupIDsField]
 	allSgIDs := make([]interface{}, len(instancePort.SecurityGroups))
@@ -262,6 +309,22 @@ func resourceInstancePortSecurityRead(ctx context.Context, d *schema.ResourceDat
 		sgsMap[SecurityGroupIDsField] = allSgIDsSet.Intersection(sgIDsSet)
 	}
 
+	if sgNamesRaw, ok := sgsMapState[SecurityGroupNamesField]; ok {
+		sgNamesSet := sgNamesRaw.(*schema.Set)
+		prevResolvedIDsSet, _ := sgsMapState[NamedSecurityGroupIDsField].(*schema.Set)
+		allSgNames := make([]interface{}, len(instancePort.SecurityGroups))
+		resolvedIDs := make([]interface{}, 0, len(instancePort.SecurityGroups))
+		for idx, sg := range instancePort.SecurityGroups {
+			allSgNames[idx] = withoutNamePrefix(m, sg.Name)
+			// the security groups resolved before are kept, even if they have been renamed since
+			if sgNamesSet.Contains(allSgNames[idx]) || (prevResolvedIDsSet != nil && prevResolvedIDsSet.Contains(sg.ID)) {
+				resolvedIDs = append(resolvedIDs, sg.ID)
+			}
+		}
+		sgsMap[SecurityGroupNamesField] = schema.NewSet(schema.HashString, allSgNames).Intersection(sgNamesSet)
+		sgsMap[NamedSecurityGroupIDsField] = schema.NewSet(schema.HashString, resolvedIDs)
+	}
+
 	sgsMap[AllSecurityGroupIDsField] = allSgIDsSet
 
 	sgsList := []interface{}{sgsMap}
@@ -286,10 +349,6 @@ func resourceInstancePortSecurityUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	diags := validatePortSecAttrs(d)
-	if diags.HasError() {
-		return diags
-	}
 	portID := d.Get(PortIDField).(string)
 	instanceID := d.Get(InstanceIDField).(string)
 	portSecurityDisabled := d.Get(PortSecurityDisabledField).(bool)
@@ -319,14 +378,14 @@ func resourceInstancePortSecurityUpdate(ctx context.Context, d *schema.ResourceD
 		return resourceInstancePortSecurityRead(ctx, d, m)
 	}
 
-	if d.HasChange(SecurityGroupsField) || d.HasChange(OverwriteExistingField) {
+	if d.HasChange(SecurityGroupsField) {
 		var sgIDsToRemoveList []interface{}
 
 		sgsOldRaw, sgsNewRaw := d.GetChange(SecurityGroupsField)
 		sgsOldList, sgsNewList := sgsOldRaw.(*schema.Set).List(), sgsNewRaw.(*schema.Set).List()
 
 		var sgsOldMap, sgsNewMap map[string]interface{}
-		var enforce bool
+		policy := PortSecurityPolicyIgnoreExternal
 		var sgIDsNewSet, sgIDsOldSet, allSgIDsOldSet *schema.Set
 
 		switch len(sgsOldList) {
@@ -343,7 +402,7 @@ func resourceInstancePortSecurityUpdate(ctx context.Context, d *schema.ResourceD
 			sgIDsOldSet = schema.NewSet(schema.HashString, []interface{}{})
 		default:
 			sgsOldMap = sgsOldList[0].(map[string]interface{})
-			sgIDsOldSet = sgsOldMap[SecurityGroupIDsField].(*schema.Set)
+			sgIDsOldSet = portSecurityStateGroupIDs(sgsOldMap)
 			allSgIDsOldSet = sgsOldMap[AllSecurityGroupIDsField].(*schema.Set)
 		}
 
@@ -352,25 +411,32 @@ func resourceInstancePortSecurityUpdate(ctx context.Context, d *schema.ResourceD
 			sgIDsNewSet = schema.NewSet(schema.HashString, []interface{}{})
 		default:
 			sgsNewMap = sgsNewList[0].(map[string]interface{})
-			enforce = sgsNewMap[OverwriteExistingField].(bool)
-			sgIDsNewSet = sgsNewMap[SecurityGroupIDsField].(*schema.Set)
+			policy = portSecurityPolicy(sgsNewMap)
+			sgIDsNewSet, err = portSecurityGroupIDs(ctx, clientV2, m, sgsNewMap)
+			if err != nil {
+				return diag.FromErr(err)
+			}
 		}
 
-		switch enforce {
-		case true:
+		switch policy {
+		case PortSecurityPolicyStrict:
 			sgIDsToRemoveList = allSgIDsOldSet.Difference(sgIDsNewSet).List()
+		case PortSecurityPolicyAdditive:
+			// the security groups are never removed
 		default:
 			sgIDsToRemoveList = sgIDsOldSet.Difference(sgIDsNewSet).List()
 		}
 
-		err = removeSecurityGroupsFromInstancePort(ctx, clientV2, instanceID, portID, sgIDsToRemoveList)
+		// The new security groups are assigned before the old ones are removed,
+		// so the port is never left without security groups while they are swapped.
+		sgsToAssignList := sgIDsNewSet.Difference(sgIDsOldSet).List()
+
+		err = AssignSecurityGroupsToInstancePort(ctx, clientV2, instanceID, portID, sgsToAssignList)
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		sgsToAssignList := sgIDsNewSet.Difference(sgIDsOldSet).List()
-
-		err = AssignSecurityGroupsToInstancePort(ctx, clientV2, instanceID, portID, sgsToAssignList)
+		err = removeSecurityGroupsFromInstancePort(ctx, clientV2, instanceID, portID, sgIDsToRemoveList)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -414,8 +480,12 @@ func resourceInstancePortSecurityDelete(ctx context.Context, d *schema.ResourceD
 	}
 	sgsList := sgsRaw.(*schema.Set).List()
 	sgsMap := sgsList[0].(map[string]interface{})
-	sgIDsSet := sgsMap[SecurityGroupIDsField].(*schema.Set)
-	sgIDsList := sgIDsSet.List()
+	if portSecurityPolicy(sgsMap) == PortSecurityPolicyAdditive {
+		d.SetId("")
+		log.Println("[DEBUG] Finish instance_port_security deleting, the security groups are kept")
+		return diags
+	}
+	sgIDsList := portSecurityStateGroupIDs(sgsMap).List()
 	err = removeSecurityGroupsFromInstancePort(ctx, clientV2, instanceID, portID, sgIDsList)
 	if err != nil {
 		return diag.FromErr(err)
@@ -426,3 +496,91 @@ func resourceInstancePortSecurityDelete(ctx context.Context, d *schema.ResourceD
 
 	return diags
 }
+
+// resourceInstancePortSecurityImport imports the port security of the instance port by <project_id>:<region_id>:<instance_id>:<port_id>.
+// The security groups attached to the port are imported as managed ones with the ignore_external policy.
+func resourceInstancePortSecurityImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	projectID, regionID, instanceID, portID, err := ImportStringParserExtended(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set(ProjectIDField, projectID)
+	d.Set(RegionIDField, regionID)
+	d.Set(InstanceIDField, instanceID)
+	d.Set(PortIDField, portID)
+	d.SetId(portID)
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return nil, err
+	}
+	instancePort, err := utilV2.InstanceNetworkPortByID(ctx, clientV2, instanceID, portID)
+	if err != nil {
+		return nil, err
+	}
+	if len(instancePort.SecurityGroups) == 0 {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	sgIDs := make([]interface{}, len(instancePort.SecurityGroups))
+	for idx, sg := range instancePort.SecurityGroups {
+		sgIDs[idx] = sg.ID
+	}
+	sgsMap := map[string]interface{}{
+		SecurityGroupIDsField:    schema.NewSet(schema.HashString, sgIDs),
+		AllSecurityGroupIDsField: schema.NewSet(schema.HashString, sgIDs),
+		ManagementPolicyField:    PortSecurityPolicyIgnoreExternal,
+	}
+	if err := d.Set(SecurityGroupsField, []interface{}{sgsMap}); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceInstancePortSecurityV0 is the schema of the resource before overwrite_existing was replaced with management_policy.
+func resourceInstancePortSecurityV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			ProjectIDField:            {Type: schema.TypeInt, Optional: true},
+			ProjectNameField:          {Type: schema.TypeString, Optional: true},
+			RegionIDField:             {Type: schema.TypeInt, Optional: true},
+			RegionNameField:           {Type: schema.TypeString, Optional: true},
+			InstanceIDField:           {Type: schema.TypeString, Required: true},
+			PortSecurityDisabledField: {Type: schema.TypeBool, Optional: true, Computed: true},
+			PortIDField:               {Type: schema.TypeString, Required: true},
+			SecurityGroupsField: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						SecurityGroupIDsField:    {Type: schema.TypeSet, Optional: true, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+						SecurityGroupNamesField:  {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+						AllSecurityGroupIDsField: {Type: schema.TypeSet, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+						OverwriteExistingField:   {Type: schema.TypeBool, Optional: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceInstancePortSecurityStateUpgradeV0 sets the default management_policy. The deprecated overwrite_existing
+// is kept and still means the strict policy, see portSecurityPolicy, so the state matches the configurations
+// which have not been changed to management_policy yet and the behavior stays the same.
+func resourceInstancePortSecurityStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	sgsList, ok := rawState[SecurityGroupsField].([]interface{})
+	if !ok {
+		return rawState, nil
+	}
+
+	for _, sgsRaw := range sgsList {
+		sgsMap, ok := sgsRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		sgsMap[ManagementPolicyField] = PortSecurityPolicyIgnoreExternal
+	}
+
+	return rawState, nil
+}
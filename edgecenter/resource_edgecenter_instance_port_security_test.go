@@ -0,0 +1,83 @@
+package edgecenter
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestResourceInstancePortSecurityStateUpgradeV0(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		rawState   map[string]interface{}
+		want       map[string]interface{}
+		wantPolicy string
+	}{
+		{
+			name: "overwrite existing",
+			rawState: map[string]interface{}{
+				PortIDField: "port",
+				SecurityGroupsField: []interface{}{map[string]interface{}{
+					SecurityGroupIDsField:  []interface{}{"sg"},
+					OverwriteExistingField: true,
+				}},
+			},
+			want: map[string]interface{}{
+				PortIDField: "port",
+				SecurityGroupsField: []interface{}{map[string]interface{}{
+					SecurityGroupIDsField:  []interface{}{"sg"},
+					OverwriteExistingField: true,
+					ManagementPolicyField:  PortSecurityPolicyIgnoreExternal,
+				}},
+			},
+			wantPolicy: PortSecurityPolicyStrict,
+		},
+		{
+			name: "keep existing",
+			rawState: map[string]interface{}{
+				PortIDField: "port",
+				SecurityGroupsField: []interface{}{map[string]interface{}{
+					SecurityGroupIDsField:  []interface{}{"sg"},
+					OverwriteExistingField: false,
+				}},
+			},
+			want: map[string]interface{}{
+				PortIDField: "port",
+				SecurityGroupsField: []interface{}{map[string]interface{}{
+					SecurityGroupIDsField:  []interface{}{"sg"},
+					OverwriteExistingField: false,
+					ManagementPolicyField:  PortSecurityPolicyIgnoreExternal,
+				}},
+			},
+			wantPolicy: PortSecurityPolicyIgnoreExternal,
+		},
+		{
+			name:     "no security groups",
+			rawState: map[string]interface{}{PortIDField: "port", PortSecurityDisabledField: true},
+			want:     map[string]interface{}{PortIDField: "port", PortSecurityDisabledField: true},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := resourceInstancePortSecurityStateUpgradeV0(context.Background(), tt.rawState, nil)
+			if err != nil {
+				t.Fatalf("resourceInstancePortSecurityStateUpgradeV0() returned an error: %s", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resourceInstancePortSecurityStateUpgradeV0() = %v, want %v", got, tt.want)
+			}
+
+			if tt.wantPolicy == "" {
+				return
+			}
+			sgsMap := got[SecurityGroupsField].([]interface{})[0].(map[string]interface{})
+			if policy := portSecurityPolicy(sgsMap); policy != tt.wantPolicy {
+				t.Errorf("portSecurityPolicy() = %q, want %q", policy, tt.wantPolicy)
+			}
+		})
+	}
+}
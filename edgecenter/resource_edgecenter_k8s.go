@@ -31,10 +31,12 @@ func resourceK8s() *schema.Resource {
 		ReadContext:   resourceK8sRead,
 		UpdateContext: resourceK8sUpdate,
 		DeleteContext: resourceK8sDelete,
+		CustomizeDiff: resourceK8sCustomizeDiff,
 		Description:   "Represent k8s cluster with one default pool.",
 		Timeouts: &schema.ResourceTimeout{
 			Create: &k8sCreateTimeout,
 			Update: &k8sCreateTimeout,
+			Delete: &k8sCreateTimeout,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -66,14 +68,17 @@ func resourceK8s() *schema.Resource {
 			"region_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -261,6 +266,36 @@ func resourceK8s() *schema.Resource {
 				Computed:    true,
 				Description: "The timestamp when the Kubernetes cluster was created.",
 			},
+			"kubeconfig_rotation_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "An arbitrary value, e.g. a date, which issues the new kubeconfig of the Kubernetes cluster when changed. " +
+					"The new kubeconfig has a new client key and a client certificate signed by the cluster CA, " +
+					"the previous client certificates stay valid until they expire. " +
+					"The kubeconfig and its parts are unknown until the apply, so the dependent providers and resources are updated in the same apply.",
+			},
+			"kubeconfig": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The kubeconfig of the Kubernetes cluster. It is issued when the cluster is created or imported and when 'kubeconfig_rotation_trigger' is changed.",
+			},
+			"certificate_authority_data": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The certificate_authority_data field from the kubeconfig.",
+			},
+			"client_certificate_data": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The client_certificate_data field from the kubeconfig.",
+			},
+			"client_key_data": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The client_key_data field from the kubeconfig.",
+			},
 			"last_updated": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -339,7 +374,7 @@ func resourceK8sCreate(ctx context.Context, d *schema.ResourceData, m interface{
 
 	taskID := results.Tasks[0]
 	log.Printf("[DEBUG] Task id (%s)", taskID)
-	k8sID, err := tasks.WaitTaskAndReturnResult(client, taskID, true, K8sCreateTimeout, func(task tasks.TaskID) (interface{}, error) {
+	k8sID, err := tasks.WaitTaskAndReturnResult(client, taskID, true, int(d.Timeout(schema.TimeoutCreate).Seconds()), func(task tasks.TaskID) (interface{}, error) {
 		taskInfo, err := tasks.Get(client, string(task)).Extract()
 		if err != nil {
 			return nil, fmt.Errorf("cannot get task with ID: %s. Error: %w", task, err)
@@ -458,6 +493,12 @@ func resourceK8sRead(_ context.Context, d *schema.ResourceData, m interface{}) d
 		return diag.FromErr(err)
 	}
 
+	if d.Get("kubeconfig").(string) == "" {
+		if err := setK8sKubeconfig(clientK8S, d, clusterID, false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	fields := []string{"region_id", "auto_healing_enabled", "pods_ip_pool", "services_ip_pool"}
 	revertState(d, &fields)
 
@@ -495,7 +536,7 @@ func resourceK8sUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 			}
 
 			taskID := results.Tasks[0]
-			_, err = tasks.WaitTaskAndReturnResult(client, taskID, true, K8sCreateTimeout, func(task tasks.TaskID) (interface{}, error) {
+			_, err = tasks.WaitTaskAndReturnResult(client, taskID, true, int(d.Timeout(schema.TimeoutUpdate).Seconds()), func(task tasks.TaskID) (interface{}, error) {
 				_, err := pools.Get(client, clusterID, poolID).Extract()
 				if err != nil {
 					return nil, fmt.Errorf("cannot get pool with ID: %s. Error: %w", poolID, err)
@@ -517,7 +558,7 @@ func resourceK8sUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 			}
 
 			taskID := results.Tasks[0]
-			_, err = tasks.WaitTaskAndReturnResult(client, taskID, true, K8sCreateTimeout, func(task tasks.TaskID) (interface{}, error) {
+			_, err = tasks.WaitTaskAndReturnResult(client, taskID, true, int(d.Timeout(schema.TimeoutUpdate).Seconds()), func(task tasks.TaskID) (interface{}, error) {
 				_, err := pools.Get(client, clusterID, poolID).Extract()
 				if err != nil {
 					return nil, fmt.Errorf("cannot get pool with ID: %s. Error: %w", poolID, err)
@@ -530,9 +571,29 @@ func resourceK8sUpdate(ctx context.Context, d *schema.ResourceData, m interface{
 		}
 	}
 
+	if d.HasChange("kubeconfig_rotation_trigger") {
+		if err := setK8sKubeconfig(client, d, d.Id(), true); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceK8sRead(ctx, d, m)
 }
 
+func resourceK8sCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("kubeconfig_rotation_trigger") {
+		return nil
+	}
+
+	for _, field := range k8sKubeconfigFields {
+		if err := d.SetNewComputed(field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceK8sDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("[DEBUG] Start K8s deleting")
 	var diags diag.Diagnostics
@@ -551,7 +612,7 @@ func resourceK8sDelete(_ context.Context, d *schema.ResourceData, m interface{})
 	}
 
 	taskID := results.Tasks[0]
-	_, err = tasks.WaitTaskAndReturnResult(client, taskID, true, K8sCreateTimeout, func(task tasks.TaskID) (interface{}, error) {
+	_, err = tasks.WaitTaskAndReturnResult(client, taskID, true, int(d.Timeout(schema.TimeoutDelete).Seconds()), func(task tasks.TaskID) (interface{}, error) {
 		_, err := clusters.Get(client, id).Extract()
 		if err == nil {
 			return nil, fmt.Errorf("cannot delete k8s cluster with ID: %s", id)
@@ -27,6 +27,7 @@ func resourceK8sPool() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: &k8sCreateTimeout,
 			Update: &k8sCreateTimeout,
+			Delete: &k8sCreateTimeout,
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -59,14 +60,17 @@ func resourceK8sPool() *schema.Resource {
 			"region_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -168,7 +172,7 @@ func resourceK8sPoolCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	taskID := results.Tasks[0]
 	log.Printf("[DEBUG] Task id (%s)", taskID)
-	poolID, err := tasks.WaitTaskAndReturnResult(client, taskID, true, K8sCreateTimeout, func(task tasks.TaskID) (interface{}, error) {
+	poolID, err := tasks.WaitTaskAndReturnResult(client, taskID, true, int(d.Timeout(schema.TimeoutCreate).Seconds()), func(task tasks.TaskID) (interface{}, error) {
 		taskInfo, err := tasks.Get(client, string(task)).Extract()
 		if err != nil {
 			return nil, fmt.Errorf("cannot get task with ID: %s. Error: %w", task, err)
@@ -252,7 +256,7 @@ func resourceK8sPoolUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		}
 
 		taskID := results.Tasks[0]
-		_, err = tasks.WaitTaskAndReturnResult(client, taskID, true, K8sCreateTimeout, func(task tasks.TaskID) (interface{}, error) {
+		_, err = tasks.WaitTaskAndReturnResult(client, taskID, true, int(d.Timeout(schema.TimeoutUpdate).Seconds()), func(task tasks.TaskID) (interface{}, error) {
 			_, err := pools.Get(client, clusterID, poolID).Extract()
 			if err != nil {
 				return nil, fmt.Errorf("cannot get pool with ID: %s. Error: %w", poolID, err)
@@ -274,7 +278,7 @@ func resourceK8sPoolUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		}
 
 		taskID := results.Tasks[0]
-		_, err = tasks.WaitTaskAndReturnResult(client, taskID, true, K8sCreateTimeout, func(task tasks.TaskID) (interface{}, error) {
+		_, err = tasks.WaitTaskAndReturnResult(client, taskID, true, int(d.Timeout(schema.TimeoutUpdate).Seconds()), func(task tasks.TaskID) (interface{}, error) {
 			_, err := pools.Get(client, clusterID, poolID).Extract()
 			if err != nil {
 				return nil, fmt.Errorf("cannot get pool with ID: %s. Error: %w", poolID, err)
@@ -308,7 +312,7 @@ func resourceK8sPoolDelete(_ context.Context, d *schema.ResourceData, m interfac
 	}
 
 	taskID := results.Tasks[0]
-	_, err = tasks.WaitTaskAndReturnResult(client, taskID, true, K8sCreateTimeout, func(task tasks.TaskID) (interface{}, error) {
+	_, err = tasks.WaitTaskAndReturnResult(client, taskID, true, int(d.Timeout(schema.TimeoutDelete).Seconds()), func(task tasks.TaskID) (interface{}, error) {
 		_, err := pools.Get(client, clusterID, id).Extract()
 		if err == nil {
 			return nil, fmt.Errorf("cannot delete k8s cluster pool with ID: %s", id)
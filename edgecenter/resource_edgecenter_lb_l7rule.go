@@ -62,12 +62,13 @@ func resourceL7Rule() *schema.Resource {
 				ExactlyOneOf: []string{RegionIDField, RegionNameField},
 			},
 			RegionNameField: {
-				Type:         schema.TypeString,
-				ForceNew:     true,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{RegionIDField, RegionNameField},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			TagsField: {
 				Type:        schema.TypeList,
@@ -31,8 +31,9 @@ func resourceLbListener() *schema.Resource {
 		DeleteContext: resourceLBListenerDelete,
 		Description:   "Represent a load balancer listener. Can not be created without a load balancer. A listener is a process that checks for connection requests using the protocol and port that you configure.",
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(5 * time.Minute),
-			Delete: schema.DefaultTimeout(5 * time.Minute),
+			Create: schema.DefaultTimeout(LBListenerCreateTimeout),
+			Update: schema.DefaultTimeout(LBListenerUpdateTimeout),
+			Delete: schema.DefaultTimeout(LBListenerDeleteTimeout),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -67,16 +68,19 @@ func resourceLbListener() *schema.Resource {
 			"region_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -255,7 +259,7 @@ func resourceLBListenerCreate(ctx context.Context, d *schema.ResourceData, m int
 		}
 	}
 
-	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Loadbalancers.ListenerCreate, &opts, clientV2, LBListenerCreateTimeout)
+	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Loadbalancers.ListenerCreate, &opts, clientV2, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -392,7 +396,7 @@ func resourceLBListenerUpdate(ctx context.Context, d *schema.ResourceData, m int
 
 		taskID := task.Tasks[0]
 
-		err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, LBListenerUpdateTimeout)
+		err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -421,7 +425,7 @@ func resourceLBListenerDelete(ctx context.Context, d *schema.ResourceData, m int
 	}
 
 	taskID := results.Tasks[0]
-	task, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, LBListenerDeleteTimeout)
+	task, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
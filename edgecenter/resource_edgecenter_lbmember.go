@@ -1,7 +1,9 @@
 package edgecenter
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net"
@@ -11,6 +13,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
 	utilV2 "github.com/Edge-Center/edgecentercloud-go/v2/util"
@@ -22,6 +25,7 @@ const (
 	LBMemberCreateTimeout = 2400 * time.Second
 	LBMemberUpdateTimeout = 2400 * time.Second
 	LBMemberDeleteTimeout = 2400 * time.Second
+	LBMemberDrainTimeout  = 300
 )
 
 func resourceLBMember() *schema.Resource {
@@ -32,8 +36,9 @@ func resourceLBMember() *schema.Resource {
 		DeleteContext: resourceLBMemberDelete,
 		Description:   "Represent load balancer member",
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(5 * time.Minute),
-			Delete: schema.DefaultTimeout(5 * time.Minute),
+			Create: schema.DefaultTimeout(LBMemberCreateTimeout),
+			Update: schema.DefaultTimeout(LBMemberUpdateTimeout),
+			Delete: schema.DefaultTimeout(LBMemberDeleteTimeout),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -68,16 +73,19 @@ func resourceLBMember() *schema.Resource {
 			"region_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"pool_id": {
 				Type:        schema.TypeString,
@@ -116,6 +124,41 @@ func resourceLBMember() *schema.Resource {
 					return diag.Errorf("Valid values: %d to %d got: %d", minWeight, maxWeight, v)
 				},
 			},
+			"monitor_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPAddress,
+				Description:  "An alternate IP address used for health monitoring of the pool member.",
+			},
+			"monitor_port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "An alternate protocol port used for health monitoring of the pool member.",
+			},
+			"drain": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If true, the member weight is set to 0 so that the member gets no new connections, " +
+					"and the apply waits until the load balancer has no active connections or 'drain_timeout' expires. " +
+					"The 'weight' is restored when it is set back to false.",
+			},
+			"drain_on_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, the member is drained as with 'drain' before it is deleted.",
+			},
+			"drain_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      LBMemberDrainTimeout,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description: "The maximum time in seconds to wait for the active connections to finish when the member is drained. " +
+					"Connections are counted for the whole load balancer, since the API has no statistics per member, " +
+					"so the wait is a best effort: when the timeout expires, the member is considered drained.",
+			},
 			"subnet_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -151,23 +194,26 @@ func resourceLBMemberCreate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	opts := &edgecloudV2.PoolMemberCreateRequest{
-		Address:      net.ParseIP(d.Get("address").(string)),
-		ProtocolPort: d.Get("protocol_port").(int),
-		Weight:       d.Get("weight").(int),
-		SubnetID:     d.Get("subnet_id").(string),
-		InstanceID:   d.Get("instance_id").(string),
+	opts := &lbPoolMemberCreate{
+		PoolMemberCreateRequest: edgecloudV2.PoolMemberCreateRequest{
+			Address:      net.ParseIP(d.Get("address").(string)),
+			ProtocolPort: d.Get("protocol_port").(int),
+			Weight:       d.Get("weight").(int),
+			SubnetID:     d.Get("subnet_id").(string),
+			InstanceID:   d.Get("instance_id").(string),
+		},
+		lbPoolMemberMonitor: lbPoolMemberMonitorFromSchema(d),
 	}
 
 	poolID := d.Get("pool_id").(string)
-	results, _, err := clientV2.Loadbalancers.PoolMemberCreate(ctx, poolID, opts)
+	results, err := createLBPoolMember(ctx, clientV2, poolID, opts)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	taskID := results.Tasks[0]
 
-	taskInfo, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, LBMemberCreateTimeout)
+	taskInfo, err := utilV2.WaitAndGetTaskInfo(ctx, clientV2, taskID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -179,6 +225,13 @@ func resourceLBMemberCreate(ctx context.Context, d *schema.ResourceData, m inter
 	pmID := poolMember.Members[0]
 
 	d.SetId(pmID)
+
+	if d.Get("drain").(bool) {
+		if err := drainLBMember(ctx, clientV2, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	resourceLBMemberRead(ctx, d, m)
 
 	log.Printf("[DEBUG] Finish LBMember creating (%s)", pmID)
@@ -197,22 +250,40 @@ func resourceLBMemberRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	poolID := d.Get("pool_id").(string)
 
-	pool, _, err := clientV2.Loadbalancers.PoolGet(ctx, poolID)
+	pool, monitors, resp, err := getLBPool(ctx, clientV2, poolID)
 	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] LBPool (%s) not found, removing LBMember from state", poolID)
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
 	mid := d.Id()
-	for _, pm := range pool.Members {
-		if mid == pm.ID {
-			d.Set("address", pm.Address.String())
-			d.Set("protocol_port", pm.ProtocolPort)
-			d.Set("weight", pm.Weight)
-			d.Set("subnet_id", pm.SubnetID)
-			d.Set("instance_id", pm.InstanceID)
-			d.Set("operating_status", pm.OperatingStatus)
+	var member *edgecloudV2.PoolMember
+	for i := range pool.Members {
+		if pool.Members[i].ID == mid {
+			member = &pool.Members[i]
+			break
 		}
 	}
+	if member == nil {
+		log.Printf("[WARN] LBMember (%s) not found in LBPool (%s), removing from state", mid, poolID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("address", member.Address.String())
+	d.Set("protocol_port", member.ProtocolPort)
+	if !d.Get("drain").(bool) {
+		d.Set("weight", member.Weight)
+	}
+	d.Set("subnet_id", member.SubnetID)
+	d.Set("instance_id", member.InstanceID)
+	d.Set("operating_status", member.OperatingStatus)
+	d.Set("monitor_address", monitors[mid].MonitorAddress)
+	d.Set("monitor_port", monitors[mid].MonitorPort)
 
 	fields := []string{"project_id", "region_id"}
 	revertState(d, &fields)
@@ -232,47 +303,29 @@ func resourceLBMemberUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 	poolID := d.Get("pool_id").(string)
 
-	pool, _, err := clientV2.Loadbalancers.PoolGet(ctx, poolID)
+	pool, monitors, _, err := getLBPool(ctx, clientV2, poolID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	members := make([]edgecloudV2.PoolMemberCreateRequest, len(pool.Members))
-	for i, pm := range pool.Members {
-		if pm.ID != d.Id() {
-			members[i] = edgecloudV2.PoolMemberCreateRequest{
-				Address:      pm.Address,
-				ProtocolPort: pm.ProtocolPort,
-				Weight:       pm.Weight,
-				SubnetID:     pm.SubnetID,
-				InstanceID:   pm.InstanceID,
-				ID:           pm.ID,
-			}
-			continue
-		}
-
-		members[i] = edgecloudV2.PoolMemberCreateRequest{
-			Address:      net.ParseIP(d.Get("address").(string)),
-			ProtocolPort: d.Get("protocol_port").(int),
-			Weight:       d.Get("weight").(int),
-			SubnetID:     d.Get("subnet_id").(string),
-			InstanceID:   d.Get("instance_id").(string),
-			ID:           d.Id(),
-		}
+	member := edgecloudV2.PoolMemberCreateRequest{
+		Address:      net.ParseIP(d.Get("address").(string)),
+		ProtocolPort: d.Get("protocol_port").(int),
+		Weight:       d.Get("weight").(int),
+		SubnetID:     d.Get("subnet_id").(string),
+		InstanceID:   d.Get("instance_id").(string),
+		ID:           d.Id(),
 	}
-
-	opts := &edgecloudV2.PoolUpdateRequest{Name: pool.Name, Members: members}
-
-	results, _, err := clientV2.Loadbalancers.PoolUpdate(ctx, pool.ID, opts)
-	if err != nil {
+	monitor := lbPoolMemberMonitorFromSchema(d)
+	if err := updateLBPoolMember(ctx, clientV2, pool, monitors, member, &monitor, d.Get("drain").(bool), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return diag.FromErr(err)
 	}
 
-	taskID := results.Tasks[0]
-
-	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, LBMemberUpdateTimeout)
-	if err != nil {
-		return diag.FromErr(err)
+	if d.HasChange("drain") && d.Get("drain").(bool) {
+		drainTimeout := time.Duration(d.Get("drain_timeout").(int)) * time.Second
+		if err := waitForLBConnectionsDrained(ctx, clientV2, pool, drainTimeout); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	d.Set("last_updated", time.Now().Format(time.RFC850))
@@ -293,6 +346,12 @@ func resourceLBMemberDelete(ctx context.Context, d *schema.ResourceData, m inter
 	mid := d.Id()
 	pid := d.Get("pool_id").(string)
 
+	if d.Get("drain_on_delete").(bool) && !d.Get("drain").(bool) {
+		if err := drainLBMember(ctx, clientV2, d, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	results, resp, err := clientV2.Loadbalancers.PoolMemberDelete(ctx, pid, mid)
 	if err != nil {
 		if resp.StatusCode == http.StatusNotFound {
@@ -305,7 +364,7 @@ func resourceLBMemberDelete(ctx context.Context, d *schema.ResourceData, m inter
 
 	taskID := results.Tasks[0]
 
-	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, LBMemberDeleteTimeout)
+	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -315,3 +374,170 @@ func resourceLBMemberDelete(ctx context.Context, d *schema.ResourceData, m inter
 
 	return diags
 }
+
+// lbPoolMemberMonitor holds the health monitoring address and port of a pool member,
+// which are missing in the pool member types of the client.
+type lbPoolMemberMonitor struct {
+	MonitorAddress string `json:"monitor_address,omitempty"`
+	MonitorPort    int    `json:"monitor_port,omitempty"`
+}
+
+func lbPoolMemberMonitorFromSchema(d *schema.ResourceData) lbPoolMemberMonitor {
+	return lbPoolMemberMonitor{
+		MonitorAddress: d.Get("monitor_address").(string),
+		MonitorPort:    d.Get("monitor_port").(int),
+	}
+}
+
+type lbPoolMemberCreate struct {
+	edgecloudV2.PoolMemberCreateRequest
+	lbPoolMemberMonitor
+}
+
+// lbPoolMemberUpdate is a pool member sent on the pool update. Unlike edgecloudV2.PoolMemberCreateRequest,
+// a zero weight is sent to the API, so that the member can be drained.
+type lbPoolMemberUpdate struct {
+	edgecloudV2.PoolMemberCreateRequest
+	lbPoolMemberMonitor
+	Weight *int `json:"weight,omitempty"`
+}
+
+type lbPoolUpdateRequest struct {
+	Name    string               `json:"name,omitempty"`
+	Members []lbPoolMemberUpdate `json:"members"`
+}
+
+type lbPoolMembersMonitorResponse struct {
+	Members []struct {
+		ID string `json:"id"`
+		lbPoolMemberMonitor
+	} `json:"members"`
+}
+
+// createLBPoolMember creates the member by a direct request, since PoolMemberCreate of the client
+// does not send the monitor address and port.
+func createLBPoolMember(ctx context.Context, client *edgecloudV2.Client, poolID string, opts *lbPoolMemberCreate) (*edgecloudV2.TaskResponse, error) {
+	path := fmt.Sprintf("/v1/lbpools/%d/%d/%s/member", client.Project, client.Region, poolID)
+	req, err := client.NewRequest(ctx, http.MethodPost, path, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	results := new(edgecloudV2.TaskResponse)
+	if _, err := client.Do(ctx, req, results); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// getLBPool returns the pool and the monitor address and port of its members by the member ID.
+// The pool is requested directly, since the pool member type of the client has no monitor fields.
+func getLBPool(ctx context.Context, client *edgecloudV2.Client, poolID string) (*edgecloudV2.Pool, map[string]lbPoolMemberMonitor, *edgecloudV2.Response, error) {
+	path := fmt.Sprintf("/v1/lbpools/%d/%d/%s", client.Project, client.Region, poolID)
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var body bytes.Buffer
+	resp, err := client.Do(ctx, req, &body)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	pool := new(edgecloudV2.Pool)
+	if err := json.Unmarshal(body.Bytes(), pool); err != nil {
+		return nil, nil, resp, err
+	}
+	poolMonitors := new(lbPoolMembersMonitorResponse)
+	if err := json.Unmarshal(body.Bytes(), poolMonitors); err != nil {
+		return nil, nil, resp, err
+	}
+
+	monitors := make(map[string]lbPoolMemberMonitor, len(poolMonitors.Members))
+	for _, pm := range poolMonitors.Members {
+		monitors[pm.ID] = pm.lbPoolMemberMonitor
+	}
+
+	return pool, monitors, resp, nil
+}
+
+// updateLBPoolMember replaces the member in the pool, the other members are sent unchanged.
+// If monitor is nil, the current monitor address and port of the member are kept.
+// If drained is true, the weight of the member is set to 0.
+func updateLBPoolMember(ctx context.Context, client *edgecloudV2.Client, pool *edgecloudV2.Pool, monitors map[string]lbPoolMemberMonitor,
+	member edgecloudV2.PoolMemberCreateRequest, monitor *lbPoolMemberMonitor, drained bool, timeout time.Duration,
+) error {
+	members := make([]lbPoolMemberUpdate, len(pool.Members))
+	for i, pm := range pool.Members {
+		if pm.ID == member.ID {
+			members[i] = lbPoolMemberUpdate{PoolMemberCreateRequest: member, lbPoolMemberMonitor: monitors[pm.ID]}
+			if monitor != nil {
+				members[i].lbPoolMemberMonitor = *monitor
+			}
+			switch {
+			case drained:
+				members[i].Weight = new(int)
+			case member.Weight > 0:
+				members[i].Weight = &member.Weight
+			}
+			continue
+		}
+
+		weight := pm.Weight
+		members[i] = lbPoolMemberUpdate{
+			PoolMemberCreateRequest: edgecloudV2.PoolMemberCreateRequest{
+				Address:      pm.Address,
+				ProtocolPort: pm.ProtocolPort,
+				SubnetID:     pm.SubnetID,
+				InstanceID:   pm.InstanceID,
+				ID:           pm.ID,
+			},
+			lbPoolMemberMonitor: monitors[pm.ID],
+			Weight:              &weight,
+		}
+	}
+
+	opts := &lbPoolUpdateRequest{Name: pool.Name, Members: members}
+
+	// the request is sent directly, since PoolUpdate of the client omits zero weights
+	path := fmt.Sprintf("/v1/lbpools/%d/%d/%s", client.Project, client.Region, pool.ID)
+	req, err := client.NewRequest(ctx, http.MethodPatch, path, opts)
+	if err != nil {
+		return err
+	}
+
+	results := new(edgecloudV2.TaskResponse)
+	if _, err := client.Do(ctx, req, results); err != nil {
+		return err
+	}
+
+	taskID := results.Tasks[0]
+
+	return utilV2.WaitForTaskComplete(ctx, client, taskID, timeout)
+}
+
+// drainLBMember sets the member weight to 0 and waits for the active connections to finish.
+func drainLBMember(ctx context.Context, client *edgecloudV2.Client, d *schema.ResourceData, timeout time.Duration) error {
+	pool, monitors, _, err := getLBPool(ctx, client, d.Get("pool_id").(string))
+	if err != nil {
+		return err
+	}
+
+	for _, pm := range pool.Members {
+		if pm.ID != d.Id() {
+			continue
+		}
+		log.Printf("[DEBUG] Draining LBMember (%s)", pm.ID)
+		member := pm.PoolMemberCreateRequest
+		member.ID = pm.ID
+		if err := updateLBPoolMember(ctx, client, pool, monitors, member, nil, true, timeout); err != nil {
+			return err
+		}
+
+		return waitForLBConnectionsDrained(ctx, client, pool, time.Duration(d.Get("drain_timeout").(int))*time.Second)
+	}
+
+	return nil
+}
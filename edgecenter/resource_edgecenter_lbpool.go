@@ -30,8 +30,9 @@ func resourceLBPool() *schema.Resource {
 		DeleteContext: resourceLBPoolDelete,
 		Description:   "Represent load balancer listener pool. A pool is a list of virtual machines to which the listener will redirect incoming traffic",
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(5 * time.Minute),
-			Delete: schema.DefaultTimeout(5 * time.Minute),
+			Create: schema.DefaultTimeout(LBPoolsCreateTimeout),
+			Update: schema.DefaultTimeout(LBPoolsUpdateTimeout),
+			Delete: schema.DefaultTimeout(LBPoolsDeleteTimeout),
 		},
 
 		Importer: &schema.ResourceImporter{
@@ -65,16 +66,19 @@ func resourceLBPool() *schema.Resource {
 			"region_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -95,9 +99,12 @@ func resourceLBPool() *schema.Resource {
 				},
 			},
 			"protocol": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: fmt.Sprintf("The protocol. Available values are `%s` (currently work, other do not work on ed-8), `%s`, `%s`, `%s`, `%s`.", edgecloudV2.LBPoolProtocolHTTP, edgecloudV2.LBPoolProtocolHTTPS, edgecloudV2.LBPoolProtocolTCP, edgecloudV2.LBPoolProtocolUDP, edgecloudV2.LBPoolProtocolProxy),
+				Type:     schema.TypeString,
+				Required: true,
+				Description: fmt.Sprintf("The protocol. Available values are `%s` (currently work, other do not work on ed-8), `%s`, `%s`, `%s`, `%s`. "+
+					"With `%s` the client address is passed to the members using the PROXY protocol, it can only be used with `%s` listeners.",
+					edgecloudV2.LBPoolProtocolHTTP, edgecloudV2.LBPoolProtocolHTTPS, edgecloudV2.LBPoolProtocolTCP, edgecloudV2.LBPoolProtocolUDP, edgecloudV2.LBPoolProtocolProxy,
+					edgecloudV2.LBPoolProtocolProxy, edgecloudV2.ListenerProtocolTCP),
 				ValidateDiagFunc: func(val interface{}, key cty.Path) diag.Diagnostics {
 					v := val.(string)
 					switch edgecloudV2.LoadbalancerPoolProtocol(v) {
@@ -179,10 +186,11 @@ func resourceLBPool() *schema.Resource {
 							Description: "The URL path. Defaults to `/`.",
 						},
 						"expected_codes": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Computed:    true,
-							Description: "The expected HTTP status codes. Multiple codes can be specified as a comma-separated string.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							Description:  "The expected HTTP status codes. Multiple codes can be specified as a comma-separated string or a range, e.g. '200,202' or '200-204'.",
+							ValidateFunc: validateHTTPExpectedCodes,
 						},
 					},
 				},
@@ -240,6 +248,18 @@ func resourceLBPoolCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
+	listenerID := d.Get("listener_id").(string)
+	if edgecloudV2.LoadbalancerPoolProtocol(d.Get("protocol").(string)) == edgecloudV2.LBPoolProtocolProxy && listenerID != "" {
+		listener, _, err := clientV2.Loadbalancers.ListenerGet(ctx, listenerID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if listener.Protocol != edgecloudV2.ListenerProtocolTCP {
+			return diag.Errorf("%s pool protocol can only be used with %s listener protocol type, listener %s has %s",
+				edgecloudV2.LBPoolProtocolProxy, edgecloudV2.ListenerProtocolTCP, listenerID, listener.Protocol)
+		}
+	}
+
 	healthOpts := extractHealthMonitorMapV2(d)
 	sessionOpts := extractSessionPersistenceMapV2(d)
 	opts := edgecloudV2.LoadbalancerPoolCreateRequest{
@@ -247,12 +267,12 @@ func resourceLBPoolCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		Protocol:              edgecloudV2.LoadbalancerPoolProtocol(d.Get("protocol").(string)),
 		LoadbalancerAlgorithm: edgecloudV2.LoadbalancerAlgorithm(d.Get("lb_algorithm").(string)),
 		LoadbalancerID:        d.Get("loadbalancer_id").(string),
-		ListenerID:            d.Get("listener_id").(string),
+		ListenerID:            listenerID,
 		HealthMonitor:         healthOpts,
 		SessionPersistence:    sessionOpts,
 	}
 
-	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Loadbalancers.PoolCreate, &edgecloudV2.PoolCreateRequest{LoadbalancerPoolCreateRequest: opts}, clientV2, LBPoolsCreateTimeout)
+	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Loadbalancers.PoolCreate, &edgecloudV2.PoolCreateRequest{LoadbalancerPoolCreateRequest: opts}, clientV2, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -372,7 +392,7 @@ func resourceLBPoolUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 
 	taskID := task.Tasks[0]
 
-	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, LBPoolsUpdateTimeout)
+	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -405,7 +425,7 @@ func resourceLBPoolDelete(ctx context.Context, d *schema.ResourceData, m interfa
 
 	taskID := results.Tasks[0]
 
-	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, LBPoolsDeleteTimeout)
+	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
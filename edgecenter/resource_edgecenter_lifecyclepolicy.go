@@ -63,16 +63,19 @@ func resourceLifecyclePolicy() *schema.Resource {
 			"region_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -310,7 +313,9 @@ func resourceLifecyclePolicyRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	id := d.Id()
-	d.Set("region_id", clientV2.Region)
+	if err := setRegionFields(ctx, clientV2, d); err != nil {
+		return diag.FromErr(err)
+	}
 	d.Set("project_id", clientV2.Project)
 	integerID, err := strconv.Atoi(id)
 	if err != nil {
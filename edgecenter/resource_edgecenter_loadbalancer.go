@@ -31,8 +31,9 @@ func resourceLoadBalancer() *schema.Resource {
 		DeleteContext:      resourceLoadBalancerDelete,
 		Description:        "Represent load balancer",
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(5 * time.Minute),
-			Delete: schema.DefaultTimeout(5 * time.Minute),
+			Create: schema.DefaultTimeout(LoadBalancerCreateTimeout),
+			Update: schema.DefaultTimeout(LoadBalancerUpdateTimeout),
+			Delete: schema.DefaultTimeout(LoadBalancerDeleteTimeout),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
@@ -85,16 +86,19 @@ func resourceLoadBalancer() *schema.Resource {
 			"region_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -121,6 +125,11 @@ func resourceLoadBalancer() *schema.Resource {
 				Description: "Load balancer IP address",
 				Computed:    true,
 			},
+			"vip_port_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the port the load balancer VIP address is allocated on.",
+				Computed:    true,
+			},
 			"listener": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -240,12 +249,14 @@ func resourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, m int
 
 	d.Set("project_id", lb.ProjectID)
 	d.Set("region_id", lb.RegionID)
+	d.Set("region_name", lb.Region)
 	d.Set("name", lb.Name)
 	d.Set("flavor", lb.Flavor.FlavorName)
 
 	if lb.VipAddress != nil {
 		d.Set("vip_address", lb.VipAddress.String())
 	}
+	d.Set("vip_port_id", lb.VipPortID)
 
 	fields := []string{"vip_network_id", "vip_subnet_id"}
 	revertState(d, &fields)
@@ -326,7 +337,7 @@ func resourceLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, m i
 			}
 
 			taskID := results.Tasks[0]
-			err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, LBListenerDeleteTimeout)
+			err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -348,7 +359,7 @@ func resourceLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, m i
 				opts.SNISecretID = sniSecretID
 			}
 
-			_, err = utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Loadbalancers.ListenerCreate, &opts, clientV2, LBListenerCreateTimeout)
+			_, err = utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Loadbalancers.ListenerCreate, &opts, clientV2, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -371,7 +382,7 @@ func resourceLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, m i
 
 			taskID := task.Tasks[0]
 
-			err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, LBListenerUpdateTimeout)
+			err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -420,7 +431,7 @@ func resourceLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, m i
 
 	taskID := results.Tasks[0]
 
-	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, LoadBalancerDeleteTimeout)
+	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
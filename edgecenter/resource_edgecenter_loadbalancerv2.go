@@ -21,8 +21,9 @@ func resourceLoadBalancerV2() *schema.Resource {
 		DeleteContext: resourceLoadBalancerV2Delete,
 		Description:   "Represent load balancer without nested listener",
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(5 * time.Minute),
-			Delete: schema.DefaultTimeout(5 * time.Minute),
+			Create: schema.DefaultTimeout(LoadBalancerCreateTimeout),
+			Update: schema.DefaultTimeout(LoadBalancerUpdateTimeout),
+			Delete: schema.DefaultTimeout(LoadBalancerDeleteTimeout),
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
@@ -56,16 +57,19 @@ func resourceLoadBalancerV2() *schema.Resource {
 			"region_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -81,9 +85,10 @@ func resourceLoadBalancerV2() *schema.Resource {
 			"vip_port_id": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"vip_network_id"},
-				Description:   "Attaches the created reserved IP.",
+				Description:   "Attaches the created reserved IP. If not specified, it is set to the ID of the VIP port created for the load balancer.",
 			},
 			"vip_network_id": {
 				Type:          schema.TypeString,
@@ -97,13 +102,19 @@ func resourceLoadBalancerV2() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				RequiredWith: []string{"vip_network_id"},
-				Description:  "The ID of the subnet in which to allocate the VIP address for the load balancer.",
+				Description: "The ID of the subnet in which to allocate the VIP address for the load balancer. " +
+					"The VIP address is IPv6 if the subnet is IPv6.",
 			},
 			"vip_address": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "Load balancer IP address",
 			},
+			"vip_ipv6_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Load balancer IP address if it is IPv6, otherwise empty.",
+			},
 			"last_updated": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -153,7 +164,7 @@ func resourceLoadBalancerV2Create(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	opts := &edgecloudV2.LoadbalancerCreateRequest{
-		Name:         d.Get("name").(string),
+		Name:         withNamePrefix(m, d.Get("name").(string)),
 		VipPortID:    d.Get("vip_port_id").(string),
 		VipNetworkID: d.Get("vip_network_id").(string),
 		VipSubnetID:  d.Get("vip_subnet_id").(string),
@@ -166,13 +177,14 @@ func resourceLoadBalancerV2Create(ctx context.Context, d *schema.ResourceData, m
 		}
 		opts.Metadata = *meta
 	}
+	opts.Metadata = withMetadataStamp(m, opts.Metadata)
 
 	lbFlavor := d.Get("flavor").(string)
 	if len(lbFlavor) != 0 {
 		opts.Flavor = lbFlavor
 	}
 
-	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Loadbalancers.Create, opts, clientV2, LoadBalancerCreateTimeout)
+	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Loadbalancers.Create, opts, clientV2, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -204,12 +216,15 @@ func resourceLoadBalancerV2Read(ctx context.Context, d *schema.ResourceData, m i
 
 	d.Set("project_id", lb.ProjectID)
 	d.Set("region_id", lb.RegionID)
-	d.Set("name", lb.Name)
+	d.Set("region_name", lb.Region)
+	d.Set("name", withoutNamePrefix(m, lb.Name))
 	d.Set("flavor", lb.Flavor.FlavorName)
 
 	if lb.VipAddress != nil {
 		d.Set("vip_address", lb.VipAddress.String())
+		d.Set("vip_ipv6_address", lbVipIPv6Address(lb.VipAddress))
 	}
+	d.Set("vip_port_id", lb.VipPortID)
 
 	fields := []string{"vip_network_id", "vip_subnet_id"}
 	revertState(d, &fields)
@@ -220,6 +235,7 @@ func resourceLoadBalancerV2Read(ctx context.Context, d *schema.ResourceData, m i
 	}
 
 	metadataMap, metadataReadOnly := PrepareMetadata(metadataList)
+	metadataMap = withoutMetadataStamp(m, d, metadataMap)
 
 	if err = d.Set("metadata_map", metadataMap); err != nil {
 		return diag.FromErr(err)
@@ -244,7 +260,7 @@ func resourceLoadBalancerV2Update(ctx context.Context, d *schema.ResourceData, m
 
 	if d.HasChange("name") {
 		opts := &edgecloudV2.Name{
-			Name: d.Get("name").(string),
+			Name: withNamePrefix(m, d.Get("name").(string)),
 		}
 		if _, _, err = clientV2.Loadbalancers.Rename(ctx, d.Id(), opts); err != nil {
 			return diag.FromErr(err)
@@ -261,7 +277,7 @@ func resourceLoadBalancerV2Update(ctx context.Context, d *schema.ResourceData, m
 			return diag.Errorf("cannot get metadata. Error: %s", err)
 		}
 
-		metadataLB := edgecloudV2.Metadata(*meta)
+		metadataLB := edgecloudV2.Metadata(withMetadataStamp(m, *meta))
 		_, err = clientV2.Loadbalancers.MetadataUpdate(ctx, d.Id(), &metadataLB)
 		if err != nil {
 			return diag.Errorf("cannot update metadata. Error: %s", err)
@@ -295,7 +311,7 @@ func resourceLoadBalancerV2Delete(ctx context.Context, d *schema.ResourceData, m
 
 	taskID := results.Tasks[0]
 
-	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, LoadBalancerDeleteTimeout)
+	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -61,14 +61,17 @@ func resourceNetwork() *schema.Resource {
 			"region_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -88,6 +91,16 @@ func resourceNetwork() *schema.Resource {
 				ForceNew:     true,
 				Description:  "'vlan' or 'vxlan' network type is allowed. Default value is 'vxlan'",
 			},
+			"shared": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Shows whether the network is shared between projects.",
+			},
+			"external": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Shows whether the network is an external (provider) network.",
+			},
 			"create_router": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -144,7 +157,7 @@ func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	networkType := d.Get("type").(string)
 	createOpts := &edgecloudV2.NetworkCreateRequest{
-		Name:         d.Get("name").(string),
+		Name:         withNamePrefix(m, d.Get("name").(string)),
 		Type:         edgecloudV2.NetworkType(networkType),
 		CreateRouter: d.Get("create_router").(bool),
 	}
@@ -157,6 +170,7 @@ func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 		createOpts.Metadata = *meta
 	}
+	createOpts.Metadata = withMetadataStamp(m, createOpts.Metadata)
 
 	log.Printf("Create network ops: %+v", createOpts)
 
@@ -209,13 +223,17 @@ func resourceNetworkRead(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.Errorf("cannot get network with ID: %s. Error: %s", networkID, err)
 	}
 
-	d.Set("name", network.Name)
+	d.Set("name", withoutNamePrefix(m, network.Name))
 	d.Set("mtu", network.MTU)
 	d.Set("type", network.Type)
+	d.Set("shared", network.Shared)
+	d.Set("external", network.External)
 	d.Set("region_id", network.RegionID)
+	d.Set("region_name", network.Region)
 	d.Set("project_id", network.ProjectID)
 
 	metadataMap, metadataReadOnly := PrepareMetadata(network.Metadata)
+	metadataMap = withoutMetadataStamp(m, d, metadataMap)
 
 	if err = d.Set("metadata_map", metadataMap); err != nil {
 		return diag.FromErr(err)
@@ -245,7 +263,7 @@ func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	if d.HasChange("name") {
 		newName := &edgecloudV2.Name{
-			Name: d.Get("name").(string),
+			Name: withNamePrefix(m, d.Get("name").(string)),
 		}
 		_, _, err := clientV2.Networks.UpdateName(ctx, networkID, newName)
 		if err != nil {
@@ -261,7 +279,8 @@ func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, m interf
 			return diag.Errorf("cannot get metadata. Error: %s", err)
 		}
 
-		_, err = clientV2.Networks.MetadataUpdate(ctx, networkID, (*edgecloudV2.Metadata)(meta))
+		metaNetwork := edgecloudV2.Metadata(withMetadataStamp(m, *meta))
+		_, err = clientV2.Networks.MetadataUpdate(ctx, networkID, &metaNetwork)
 		if err != nil {
 			return diag.Errorf("cannot update metadata. Error: %s", err)
 		}
@@ -0,0 +1,493 @@
+package edgecenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloud "github.com/Edge-Center/edgecentercloud-go"
+	"github.com/Edge-Center/edgecentercloud-go/edgecenter"
+	"github.com/Edge-Center/edgecentercloud-go/edgecenter/k8s/v1/clusters"
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+	utilV2 "github.com/Edge-Center/edgecentercloud-go/v2/util"
+)
+
+const (
+	ProjectPurgeTimeout = 3600 * time.Second
+
+	ProjectPurgeK8sClusters      = "k8s_clusters"
+	ProjectPurgeInstances        = "instances"
+	ProjectPurgeLoadbalancers    = "loadbalancers"
+	ProjectPurgeFloatingIPs      = "floating_ips"
+	ProjectPurgeVolumes          = "volumes"
+	ProjectPurgeReservedFixedIPs = "reserved_fixed_ips"
+	ProjectPurgeRouters          = "routers"
+	ProjectPurgeSubnets          = "subnets"
+	ProjectPurgeNetworks         = "networks"
+	ProjectPurgeSecurityGroups   = "security_groups"
+)
+
+// projectPurgeResourceTypes lists the resource types in the order they are deleted,
+// so the resources are deleted before the ones they depend on.
+// The k8s clusters go first, since they own their instances and load balancers.
+var projectPurgeResourceTypes = []string{
+	ProjectPurgeK8sClusters,
+	ProjectPurgeInstances,
+	ProjectPurgeLoadbalancers,
+	ProjectPurgeFloatingIPs,
+	ProjectPurgeVolumes,
+	ProjectPurgeReservedFixedIPs,
+	ProjectPurgeRouters,
+	ProjectPurgeSubnets,
+	ProjectPurgeNetworks,
+	ProjectPurgeSecurityGroups,
+}
+
+// projectPurgeItem is a resource found in the project to be deleted.
+type projectPurgeItem struct {
+	Type string
+	ID   string
+	Name string
+}
+
+func resourceProjectPurge() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectPurgeCreate,
+		ReadContext:   resourceProjectPurgeRead,
+		DeleteContext: resourceProjectPurgeDelete,
+		CustomizeDiff: resourceProjectPurgeCustomizeDiff,
+		Description: "Deletes the resources of the selected types in the project and region when it is created. " +
+			"The resources to be deleted are listed in 'resources' of the plan. " +
+			"Intended for ephemeral environments, e.g. preview environments torn down by CI. " +
+			"Only the listed resource types are deleted, e.g. file shares, images, snapshots and secrets are kept. " +
+			"Destroying this resource deletes nothing.",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(ProjectPurgeTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			ProjectIDField: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{ProjectIDField, ProjectNameField},
+			},
+			ProjectNameField: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{ProjectIDField, ProjectNameField},
+			},
+			RegionIDField: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{RegionIDField, RegionNameField},
+			},
+			RegionNameField: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{RegionIDField, RegionNameField},
+				DiffSuppressFunc: suppressRegionNameDiffs,
+			},
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(projectPurgeResourceTypes, false),
+				},
+				Description: fmt.Sprintf("The types of the resources to be deleted. Possible values are %s.",
+					strings.Join(projectPurgeResourceTypes, ", ")),
+			},
+			"dry_run": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "If true, the resources are only listed in 'resources' of the plan and in a warning, nothing is deleted.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that purge the project again when they change.",
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "The resources deleted, or the resources that would be deleted in dry run mode. " +
+					"All of them are listed before the first one is deleted, as in the plan, " +
+					"so the resources deleted together with others, e.g. the instances of the k8s clusters, are listed as well.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceProjectPurgeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start project purging")
+	var diags diag.Diagnostics
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d:%d", clientV2.Project, clientV2.Region))
+
+	// the resources are listed the same way as in the plan, before any of them is deleted,
+	// so the resources deleted together with the previous types, e.g. the instances of the k8s clusters, are kept in the list
+	var items []projectPurgeItem
+	for _, t := range projectPurgeTypes(d) {
+		typeItems, err := listProjectPurgeItems(ctx, clientV2, m, t)
+		if err != nil {
+			return diag.Errorf("cannot list %s: %s", t, err)
+		}
+		items = append(items, typeItems...)
+	}
+
+	dryRun := d.Get("dry_run").(bool)
+	var purged []projectPurgeItem
+	for _, item := range items {
+		if !dryRun {
+			log.Printf("[DEBUG] Deleting %s %s (%s)", item.Type, item.ID, item.Name)
+			if err := deleteProjectPurgeItem(ctx, clientV2, m, item); err != nil {
+				d.Set("resources", flattenProjectPurgeItems(purged))
+				return diag.Errorf("cannot delete %s %s (%s): %s", item.Type, item.ID, item.Name, err)
+			}
+		}
+		purged = append(purged, item)
+	}
+
+	if err := d.Set("resources", flattenProjectPurgeItems(purged)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if dryRun {
+		lines := make([]string, 0, len(purged))
+		for _, item := range purged {
+			lines = append(lines, fmt.Sprintf("%s %s (%s)", item.Type, item.ID, item.Name))
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Dry run: %d resources would be deleted", len(purged)),
+			Detail:   strings.Join(lines, "\n"),
+		})
+	}
+
+	log.Printf("[DEBUG] Finish project purging (%d resources)", len(purged))
+
+	return diags
+}
+
+// resourceProjectPurgeCustomizeDiff lists the resources to be deleted in 'resources' of the plan,
+// when the resource is created or replaced and its project and region are known.
+func resourceProjectPurgeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" && !d.HasChanges(ProjectIDField, ProjectNameField, RegionIDField, RegionNameField, "resource_types", "dry_run", "triggers") {
+		return nil
+	}
+	for _, field := range []string{ProjectIDField, ProjectNameField, RegionIDField, RegionNameField} {
+		if !d.NewValueKnown(field) {
+			return nil
+		}
+	}
+
+	clientV2, err := instanceDiffCloudClient(ctx, d, m)
+	if err != nil {
+		return err
+	}
+
+	var planned []projectPurgeItem
+	for _, t := range projectPurgeTypes(d) {
+		items, err := listProjectPurgeItems(ctx, clientV2, m, t)
+		if err != nil {
+			return fmt.Errorf("cannot list %s: %w", t, err)
+		}
+		planned = append(planned, items...)
+	}
+
+	return d.SetNew("resources", flattenProjectPurgeItems(planned))
+}
+
+func resourceProjectPurgeRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceProjectPurgeDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
+
+// projectPurgeTypes returns the selected resource types in the order they are deleted.
+func projectPurgeTypes(d interface{ Get(string) interface{} }) []string {
+	rawTypes := d.Get("resource_types").(*schema.Set)
+	types := make([]string, 0, rawTypes.Len())
+	for _, t := range projectPurgeResourceTypes {
+		if rawTypes.Contains(t) {
+			types = append(types, t)
+		}
+	}
+
+	return types
+}
+
+// projectPurgeK8sClient creates the client of the k8s clusters, which are not supported by the cloud client,
+// for the project and region of the cloud client.
+func projectPurgeK8sClient(client *edgecloudV2.Client, m interface{}) (*edgecloud.ServiceClient, error) {
+	return edgecenter.ClientServiceFromProvider(m.(*Config).Provider, edgecloud.EndpointOpts{
+		Name:    K8sPoint,
+		Region:  client.Region,
+		Project: client.Project,
+		Version: VersionPointV1,
+	})
+}
+
+// listProjectPurgeItems returns the resources of the type that are owned by the project.
+// The default security group of the project, external and shared networks and their subnets are skipped.
+func listProjectPurgeItems(ctx context.Context, client *edgecloudV2.Client, m interface{}, resourceType string) ([]projectPurgeItem, error) {
+	var items []projectPurgeItem
+	switch resourceType {
+	case ProjectPurgeK8sClusters:
+		k8sClient, err := projectPurgeK8sClient(client, m)
+		if err != nil {
+			return nil, err
+		}
+		k8sClusters, err := clusters.ListAll(k8sClient, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range k8sClusters {
+			items = append(items, projectPurgeItem{Type: resourceType, ID: cluster.UUID, Name: cluster.Name})
+		}
+	case ProjectPurgeInstances:
+		instances, _, err := client.Instances.List(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range instances {
+			items = append(items, projectPurgeItem{Type: resourceType, ID: instance.ID, Name: instance.Name})
+		}
+	case ProjectPurgeLoadbalancers:
+		lbs, _, err := client.Loadbalancers.List(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, lb := range lbs {
+			items = append(items, projectPurgeItem{Type: resourceType, ID: lb.ID, Name: lb.Name})
+		}
+	case ProjectPurgeFloatingIPs:
+		fips, _, err := client.Floatingips.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, fip := range fips {
+			items = append(items, projectPurgeItem{Type: resourceType, ID: fip.ID, Name: fip.FloatingIPAddress})
+		}
+	case ProjectPurgeVolumes:
+		volumes, _, err := client.Volumes.List(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, volume := range volumes {
+			items = append(items, projectPurgeItem{Type: resourceType, ID: volume.ID, Name: volume.Name})
+		}
+	case ProjectPurgeReservedFixedIPs:
+		reservedFixedIPs, _, err := client.ReservedFixedIP.List(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, reservedFixedIP := range reservedFixedIPs {
+			items = append(items, projectPurgeItem{Type: resourceType, ID: reservedFixedIP.PortID, Name: reservedFixedIP.FixedIPAddress.String()})
+		}
+	case ProjectPurgeRouters:
+		routers, _, err := client.Routers.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, router := range routers {
+			items = append(items, projectPurgeItem{Type: resourceType, ID: router.ID, Name: router.Name})
+		}
+	case ProjectPurgeSubnets:
+		networks, _, err := client.Networks.List(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		skippedNetworks := make(map[string]bool)
+		for _, network := range networks {
+			if network.External || network.Shared {
+				skippedNetworks[network.ID] = true
+			}
+		}
+		subnets, _, err := client.Subnetworks.List(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, subnet := range subnets {
+			if skippedNetworks[subnet.NetworkID] {
+				continue
+			}
+			items = append(items, projectPurgeItem{Type: resourceType, ID: subnet.ID, Name: subnet.Name})
+		}
+	case ProjectPurgeNetworks:
+		networks, _, err := client.Networks.List(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, network := range networks {
+			if network.External || network.Shared {
+				continue
+			}
+			items = append(items, projectPurgeItem{Type: resourceType, ID: network.ID, Name: network.Name})
+		}
+	case ProjectPurgeSecurityGroups:
+		sgs, err := listProjectPurgeSecurityGroups(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		for _, sg := range sgs {
+			if sg.Default {
+				continue
+			}
+			items = append(items, projectPurgeItem{Type: resourceType, ID: sg.ID, Name: sg.Name})
+		}
+	}
+
+	return items, nil
+}
+
+// projectPurgeSecurityGroup is a security group with the flag of the default security group of the project,
+// which is not exposed by the cloud client.
+type projectPurgeSecurityGroup struct {
+	edgecloudV2.SecurityGroup
+	Default bool `json:"default"`
+}
+
+func listProjectPurgeSecurityGroups(ctx context.Context, client *edgecloudV2.Client) ([]projectPurgeSecurityGroup, error) {
+	path := fmt.Sprintf("/v1/%s/%d/%d", SecurityGroupPoint, client.Project, client.Region)
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	var root struct {
+		Results []projectPurgeSecurityGroup `json:"results"`
+	}
+	if _, err := client.Do(ctx, req, &root); err != nil {
+		return nil, err
+	}
+
+	return root.Results, nil
+}
+
+// deleteProjectPurgeItem deletes the resource and waits for it to be deleted.
+// The resource that is not found has been deleted together with another one, e.g. the instance of the k8s cluster.
+func deleteProjectPurgeItem(ctx context.Context, client *edgecloudV2.Client, m interface{}, item projectPurgeItem) error {
+	var (
+		result *edgecloudV2.TaskResponse
+		resp   *edgecloudV2.Response
+		err    error
+	)
+	switch item.Type {
+	case ProjectPurgeK8sClusters:
+		k8sClient, err := projectPurgeK8sClient(client, m)
+		if err != nil {
+			return err
+		}
+		k8sResult, err := clusters.Delete(k8sClient, item.ID).Extract()
+		if err != nil {
+			var errDefault404 edgecloud.Default404Error
+			if errors.As(err, &errDefault404) {
+				return nil
+			}
+			return err
+		}
+		if len(k8sResult.Tasks) == 0 {
+			return errors.New("no task returned for the deletion")
+		}
+		// the tasks are the same for both clients
+		return utilV2.WaitForTaskComplete(ctx, client, string(k8sResult.Tasks[0]))
+	case ProjectPurgeInstances:
+		result, resp, err = client.Instances.Delete(ctx, item.ID, nil)
+	case ProjectPurgeLoadbalancers:
+		result, resp, err = client.Loadbalancers.Delete(ctx, item.ID)
+	case ProjectPurgeFloatingIPs:
+		result, resp, err = client.Floatingips.Delete(ctx, item.ID)
+	case ProjectPurgeVolumes:
+		result, resp, err = client.Volumes.Delete(ctx, item.ID)
+	case ProjectPurgeReservedFixedIPs:
+		result, resp, err = client.ReservedFixedIP.Delete(ctx, item.ID)
+	case ProjectPurgeRouters:
+		result, resp, err = client.Routers.Delete(ctx, item.ID)
+	case ProjectPurgeSubnets:
+		result, resp, err = client.Subnetworks.Delete(ctx, item.ID)
+	case ProjectPurgeNetworks:
+		result, resp, err = client.Networks.Delete(ctx, item.ID)
+	case ProjectPurgeSecurityGroups:
+		resp, err = client.SecurityGroups.Delete(ctx, item.ID)
+		invalidateSecurityGroupNames(m, client)
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(result.Tasks) == 0 {
+		return errors.New("no task returned for the deletion")
+	}
+
+	return utilV2.WaitForTaskComplete(ctx, client, result.Tasks[0])
+}
+
+func flattenProjectPurgeItems(items []projectPurgeItem) []interface{} {
+	result := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		result = append(result, map[string]interface{}{
+			"type": item.Type,
+			"id":   item.ID,
+			"name": item.Name,
+		})
+	}
+
+	return result
+}
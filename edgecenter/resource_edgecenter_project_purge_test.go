@@ -0,0 +1,48 @@
+package edgecenter
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestProjectPurgeTypes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		types []interface{}
+		want  []string
+	}{
+		{
+			name:  "deletion order",
+			types: []interface{}{ProjectPurgeSecurityGroups, ProjectPurgeVolumes, ProjectPurgeK8sClusters, ProjectPurgeInstances},
+			want:  []string{ProjectPurgeK8sClusters, ProjectPurgeInstances, ProjectPurgeVolumes, ProjectPurgeSecurityGroups},
+		},
+		{
+			name:  "single type",
+			types: []interface{}{ProjectPurgeFloatingIPs},
+			want:  []string{ProjectPurgeFloatingIPs},
+		},
+		{
+			name:  "all types",
+			types: []interface{}{ProjectPurgeNetworks, ProjectPurgeSubnets, ProjectPurgeRouters, ProjectPurgeReservedFixedIPs, ProjectPurgeVolumes, ProjectPurgeFloatingIPs, ProjectPurgeLoadbalancers, ProjectPurgeInstances, ProjectPurgeK8sClusters, ProjectPurgeSecurityGroups},
+			want:  projectPurgeResourceTypes,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			d := schema.TestResourceDataRaw(t, resourceProjectPurge().Schema, map[string]interface{}{
+				ProjectIDField:   1,
+				RegionIDField:    1,
+				"resource_types": tt.types,
+			})
+			if got := projectPurgeTypes(d); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("projectPurgeTypes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
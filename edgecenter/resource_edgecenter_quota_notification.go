@@ -0,0 +1,174 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	QuotaNotificationResource = "edgecenter_quota_notification"
+
+	QuotaNotificationThresholdField   = "threshold"
+	QuotaNotificationLastSendingField = "last_sending"
+)
+
+func resourceQuotaNotification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceQuotaNotificationCreate,
+		ReadContext:   resourceQuotaNotificationRead,
+		UpdateContext: resourceQuotaNotificationUpdate,
+		DeleteContext: resourceQuotaNotificationDelete,
+		Description: "Represent a quota usage notification threshold of the client. " +
+			"A notification is sent to the client's email when the usage of any quota reaches the threshold.",
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceQuotaNotificationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			ClientIDField: {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the client.",
+			},
+			QuotaNotificationThresholdField: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  "The quota usage, in percent, at which the notification is sent.",
+			},
+			QuotaNotificationLastSendingField: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The datetime the last notification was sent.",
+			},
+		},
+	}
+}
+
+func resourceQuotaNotificationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start quota notification creating")
+	clientConf := CloudClientConf{
+		DoNotUseRegionID:  true,
+		DoNotUseProjectID: true,
+	}
+	clientV2, err := InitCloudClient(ctx, d, m, &clientConf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientID := d.Get(ClientIDField).(int)
+	opts := &edgecloudV2.NotificationThresholdUpdateRequest{
+		Threshold: d.Get(QuotaNotificationThresholdField).(int),
+	}
+
+	if _, _, err := clientV2.Quotas.UpdateNotificationThreshold(ctx, clientID, opts); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(clientID))
+
+	log.Printf("[DEBUG] Finish quota notification creating (%d)", clientID)
+
+	return resourceQuotaNotificationRead(ctx, d, m)
+}
+
+func resourceQuotaNotificationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start quota notification reading")
+	clientConf := CloudClientConf{
+		DoNotUseRegionID:  true,
+		DoNotUseProjectID: true,
+	}
+	clientV2, err := InitCloudClient(ctx, d, m, &clientConf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clientID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	threshold, response, err := clientV2.Quotas.GetNotificationThreshold(ctx, clientID)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing quota notification %s because resource doesn't exist anymore", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set(ClientIDField, clientID)
+	d.Set(QuotaNotificationThresholdField, threshold.Threshold)
+	d.Set(QuotaNotificationLastSendingField, threshold.LastSending)
+
+	log.Println("[DEBUG] Finish quota notification reading")
+
+	return nil
+}
+
+func resourceQuotaNotificationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start quota notification updating")
+	clientConf := CloudClientConf{
+		DoNotUseRegionID:  true,
+		DoNotUseProjectID: true,
+	}
+	clientV2, err := InitCloudClient(ctx, d, m, &clientConf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(QuotaNotificationThresholdField) {
+		opts := &edgecloudV2.NotificationThresholdUpdateRequest{
+			Threshold: d.Get(QuotaNotificationThresholdField).(int),
+		}
+		if _, _, err := clientV2.Quotas.UpdateNotificationThreshold(ctx, d.Get(ClientIDField).(int), opts); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Println("[DEBUG] Finish quota notification updating")
+
+	return resourceQuotaNotificationRead(ctx, d, m)
+}
+
+func resourceQuotaNotificationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start quota notification deleting")
+	clientConf := CloudClientConf{
+		DoNotUseRegionID:  true,
+		DoNotUseProjectID: true,
+	}
+	clientV2, err := InitCloudClient(ctx, d, m, &clientConf)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := clientV2.Quotas.DeleteNotificationThreshold(ctx, d.Get(ClientIDField).(int)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	log.Println("[DEBUG] Finish quota notification deleting")
+
+	return nil
+}
+
+func resourceQuotaNotificationImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	clientID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("invalid client ID %q: %w", d.Id(), err)
+	}
+	d.Set(ClientIDField, clientID)
+
+	return []*schema.ResourceData{d}, nil
+}
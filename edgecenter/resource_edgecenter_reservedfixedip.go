@@ -59,14 +59,17 @@ func resourceReservedFixedIP() *schema.Resource {
 			"region_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"type": {
 				Type:        schema.TypeString,
@@ -296,6 +299,7 @@ func resourceReservedFixedIPRead(ctx context.Context, d *schema.ResourceData, m
 
 	d.Set("project_id", reservedFixedIP.ProjectID)
 	d.Set("region_id", reservedFixedIP.RegionID)
+	d.Set("region_name", reservedFixedIP.Region)
 	d.Set("status", reservedFixedIP.Status)
 	d.Set("fixed_ip_address", reservedFixedIP.FixedIPAddress.String())
 	d.Set("subnet_id", reservedFixedIP.SubnetID)
@@ -371,6 +375,14 @@ func resourceReservedFixedIPUpdate(ctx context.Context, d *schema.ResourceData,
 
 	id := d.Id()
 	if d.HasChange("is_vip") {
+		// candidate ports have to be disconnected before the VIP is switched off,
+		// otherwise the API refuses to change the status
+		if !isVip && oldInstancePortsSet.Len() != 0 {
+			err = retryReplaceInstancePorts(ctx, clientV2, id, edgecloudV2.AddInstancePortsRequest{PortIDs: []string{}})
+			if err != nil {
+				return diag.Errorf("Error from disconnecting instance ports before switching is_vip status to false: %s", err)
+			}
+		}
 		opts := &edgecloudV2.SwitchVIPStatusRequest{IsVIP: d.Get("is_vip").(bool)}
 		_, _, err := clientV2.ReservedFixedIP.SwitchVIPStatus(ctx, id, opts)
 		if err != nil {
@@ -55,14 +55,17 @@ func resourceRouter() *schema.Resource {
 			"region_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -255,6 +258,7 @@ func resourceRouterRead(ctx context.Context, d *schema.ResourceData, m interface
 		return diag.Errorf("cannot get router with ID: %s. Error: %s", routerID, err)
 	}
 
+	d.Set("region_name", router.Region)
 	d.Set("name", router.Name)
 
 	if len(router.ExternalGatewayInfo.ExternalFixedIPs) > 0 {
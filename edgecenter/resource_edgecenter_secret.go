@@ -2,6 +2,7 @@ package edgecenter
 
 import (
 	"context"
+	"errors"
 	"log"
 	"net/http"
 	"time"
@@ -28,6 +29,7 @@ func resourceSecret() *schema.Resource {
 		CreateContext: resourceSecretCreate,
 		ReadContext:   resourceSecretRead,
 		DeleteContext: resourceSecretDelete,
+		CustomizeDiff: resourceSecretCustomizeDiff,
 		Description:   "Represent secret",
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -61,16 +63,19 @@ func resourceSecret() *schema.Resource {
 			"region_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -79,22 +84,42 @@ func resourceSecret() *schema.Resource {
 				Description: "The name of the secret.",
 			},
 			"private_key": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "SSL private key in PEM format",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"pkcs12"},
+				Description:   "SSL private key in PEM format",
 			},
 			"certificate_chain": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "SSL certificate chain of intermediates and root certificates in PEM format",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"pkcs12"},
+				Description:   "SSL certificate chain of intermediates and root certificates in PEM format",
 			},
 			"certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"certificate", "pkcs12"},
+				Description:  "SSL certificate in PEM format. Either 'certificate' or 'pkcs12' must be specified.",
+			},
+			"pkcs12": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"certificate", "pkcs12"},
+				Description: "Base64 encoded PKCS12 bundle with the SSL certificate, the private key and the chain of intermediates, " +
+					"e.g. filebase64(\"cert.p12\"). The bundle is split into the certificate, the private key and the chain when the secret is created. " +
+					"Either 'certificate' or 'pkcs12' must be specified.",
+			},
+			"pkcs12_passphrase": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				ForceNew:    true,
-				Description: "SSL certificate in PEM format",
+				Sensitive:   true,
+				Description: "The passphrase of the PKCS12 bundle, used only with 'pkcs12'.",
 			},
 			"algorithm": {
 				Type:        schema.TypeString,
@@ -160,13 +185,21 @@ func resourceSecretCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
+	payload := edgecloudV2.Payload{
+		CertificateChain: d.Get("certificate_chain").(string),
+		Certificate:      d.Get("certificate").(string),
+		PrivateKey:       d.Get("private_key").(string),
+	}
+	if bundle := d.Get("pkcs12").(string); bundle != "" {
+		payload.Certificate, payload.CertificateChain, payload.PrivateKey, err = SplitPKCS12(bundle, d.Get("pkcs12_passphrase").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	opts := &edgecloudV2.SecretCreateRequestV2{
-		Name: d.Get("name").(string),
-		Payload: edgecloudV2.Payload{
-			CertificateChain: d.Get("certificate_chain").(string),
-			Certificate:      d.Get("certificate").(string),
-			PrivateKey:       d.Get("private_key").(string),
-		},
+		Name:    d.Get("name").(string),
+		Payload: payload,
 	}
 	if rawTime := d.Get("expiration").(string); rawTime != "" {
 		opts.Expiration = &rawTime
@@ -190,6 +223,45 @@ func resourceSecretCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	return diags
 }
 
+// resourceSecretCustomizeDiff checks the fields of the PKCS12 mode, the PEM fields are checked by the API as before,
+// and plans the replacement of the expired secret, see secretExpired.
+func resourceSecretCustomizeDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.NewValueKnown("pkcs12") && d.NewValueKnown("pkcs12_passphrase") &&
+		d.Get("pkcs12_passphrase").(string) != "" && d.Get("pkcs12").(string) == "" {
+		return errors.New("'pkcs12_passphrase' can only be specified with 'pkcs12'")
+	}
+
+	config, ok := m.(*Config)
+	if d.Id() == "" || !ok || !config.Features.RecreateSecretOnExpiry {
+		return nil
+	}
+	oldExpiration, _ := d.GetChange("expiration")
+	if !secretExpired(oldExpiration.(string), time.Now()) {
+		return nil
+	}
+
+	log.Printf("[WARN] Secret %s has expired at %s, it will be replaced", d.Id(), oldExpiration)
+	// the expiration moved forward in the configuration is planned as is, otherwise it is set by the new secret
+	if !d.HasChange("expiration") {
+		if err := d.SetNewComputed("expiration"); err != nil {
+			return err
+		}
+	}
+
+	return d.ForceNew("expiration")
+}
+
+// secretExpired checks whether the expiration of the secret in the state has passed.
+// The secret without expiration is stored with the zero time, which never expires.
+func secretExpired(expiration string, now time.Time) bool {
+	expTime, err := time.Parse(RFC3339NoZ, expiration)
+	if err != nil || expTime.IsZero() {
+		return false
+	}
+
+	return expTime.Before(now)
+}
+
 func resourceSecretRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("[DEBUG] Start secret reading")
 	var diags diag.Diagnostics
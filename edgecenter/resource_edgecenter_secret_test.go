@@ -0,0 +1,80 @@
+package edgecenter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestSecretExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		expiration string
+		want       bool
+	}{
+		{name: "expired", expiration: "2024-06-30T12:00:00", want: true},
+		{name: "not expired", expiration: "2024-07-02T12:00:00", want: false},
+		{name: "without expiration", expiration: time.Time{}.Format(RFC3339NoZ), want: false},
+		{name: "empty", expiration: "", want: false},
+	}
+	for _, tt := range tests {
+		if got := secretExpired(tt.expiration, now); got != tt.want {
+			t.Errorf("secretExpired(%q) = %t, want %t", tt.expiration, got, tt.want)
+		}
+	}
+}
+
+// TestResourceSecretExpiredPlan checks that the expired secret is planned for replacement
+// while its state is kept as read from the API.
+func TestResourceSecretExpiredPlan(t *testing.T) {
+	t.Parallel()
+
+	const expired = "2020-01-01T00:00:00"
+	state := &terraform.InstanceState{
+		ID: "secret",
+		Attributes: map[string]string{
+			"id":          "secret",
+			"project_id":  "1",
+			"region_id":   "1",
+			"name":        "secret",
+			"certificate": "cert",
+			"private_key": "key",
+			"expiration":  expired,
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id":  1,
+		"region_id":   1,
+		"name":        "secret",
+		"certificate": "cert",
+		"private_key": "key",
+	})
+
+	tests := []struct {
+		name        string
+		recreate    bool
+		wantReplace bool
+	}{
+		{name: "feature enabled", recreate: true, wantReplace: true},
+		{name: "feature disabled", recreate: false, wantReplace: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			meta := &Config{Features: Features{RecreateSecretOnExpiry: tt.recreate}}
+			diff, err := resourceSecret().Diff(context.Background(), state.DeepCopy(), config, meta)
+			if err != nil {
+				t.Fatalf("Diff() returned an error: %s", err)
+			}
+			if got := diff != nil && diff.RequiresNew(); got != tt.wantReplace {
+				t.Errorf("the secret is replaced = %t, want %t", got, tt.wantReplace)
+			}
+		})
+	}
+}
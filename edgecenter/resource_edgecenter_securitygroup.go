@@ -67,11 +67,12 @@ func resourceSecurityGroup() *schema.Resource {
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -113,11 +114,28 @@ func resourceSecurityGroup() *schema.Resource {
 					},
 				},
 			},
+			"copy_rules_from_securitygroup_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "The uuid of the security group the rules are copied from when the security group is created. " +
+					"The rules referencing the source security group reference the new one. " +
+					"The copied rules are shown in 'security_group_rules' and can't be changed while this field is set; " +
+					"to manage them, replace this field with 'security_group_rules', removing it doesn't recreate the security group. " +
+					"Either 'security_group_rules' or 'copy_rules_from_securitygroup_id' must be specified.",
+				ExactlyOneOf: []string{"security_group_rules", "copy_rules_from_securitygroup_id"},
+				DiffSuppressFunc: func(_, _, newValue string, d *schema.ResourceData) bool {
+					// the rules are copied only on create, so removing the field from an existing security group changes nothing
+					return newValue == "" && d.Id() != ""
+				},
+			},
 			"security_group_rules": {
-				Type:        schema.TypeSet,
-				Required:    true,
-				Description: "Firewall rules control what inbound(ingress) and outbound(egress) traffic is allowed to enter or leave a Instance. At least one 'egress' rule should be set",
-				Set:         secGroupUniqueID,
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"security_group_rules", "copy_rules_from_securitygroup_id"},
+				Description:  "Firewall rules control what inbound(ingress) and outbound(egress) traffic is allowed to enter or leave a Instance. At least one 'egress' rule should be set",
+				Set:          secGroupUniqueID,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
@@ -151,9 +169,24 @@ func resourceSecurityGroup() *schema.Resource {
 							},
 						},
 						"protocol": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: fmt.Sprintf("Available value is %s", strings.Join(edgecloudV2.SecurityGroupRuleProtocol("").StringList(), ",")),
+							Type:             schema.TypeString,
+							Required:         true,
+							Description:      fmt.Sprintf("Available value is %s", strings.Join(edgecloudV2.SecurityGroupRuleProtocol("").StringList(), ",")),
+							ValidateDiagFunc: validateSecurityGroupRuleProtocol,
+						},
+						"icmp_type": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      sgRuleICMPAny,
+							ValidateFunc: validation.IntBetween(sgRuleICMPAny, 255),
+							Description:  "ICMP type of the 'icmp' rule, -1 means any type. If set, port_range_min and port_range_max are ignored.",
+						},
+						"icmp_code": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      sgRuleICMPAny,
+							ValidateFunc: validation.IntBetween(sgRuleICMPAny, 255),
+							Description:  "ICMP code of the 'icmp' rule, -1 means any code. Used only with icmp_type.",
 						},
 						"port_range_min": {
 							Type:         schema.TypeInt,
@@ -201,17 +234,20 @@ func resourceSecurityGroup() *schema.Resource {
 func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("[DEBUG] Start SecurityGroup creating")
 
-	var valid bool
-	vals := d.Get("security_group_rules").(*schema.Set).List()
-	for _, val := range vals {
-		rule := val.(map[string]interface{})
-		if edgecloudV2.SecurityGroupRuleDirection(rule["direction"].(string)) == edgecloudV2.SGRuleDirectionEgress {
-			valid = true
-			break
+	copyRulesFrom := d.Get("copy_rules_from_securitygroup_id").(string)
+	if copyRulesFrom == "" {
+		var valid bool
+		vals := d.Get("security_group_rules").(*schema.Set).List()
+		for _, val := range vals {
+			rule := val.(map[string]interface{})
+			if edgecloudV2.SecurityGroupRuleDirection(rule["direction"].(string)) == edgecloudV2.SGRuleDirectionEgress {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return diag.Errorf("at least one 'egress' rule should be set")
 		}
-	}
-	if !valid {
-		return diag.Errorf("at least one 'egress' rule should be set")
 	}
 
 	var diags diag.Diagnostics
@@ -252,12 +288,24 @@ func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, m
 
 		sgrOpts.PortRangeMax = &portRangeMax
 		sgrOpts.PortRangeMin = &portRangeMin
+		if icmpType, icmpCode, ok := securityGroupRuleICMP(rule); ok {
+			sgrOpts.PortRangeMin = &icmpType
+			sgrOpts.PortRangeMax = icmpCode
+		}
 
 		rules[i] = sgrOpts
 	}
 
+	var selfRules []edgecloudV2.RuleCreateRequest
+	if copyRulesFrom != "" {
+		rules, selfRules, err = copySecurityGroupRules(ctx, clientV2, copyRulesFrom)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	createSecurityGroupOpts := &edgecloudV2.SecurityGroupCreateRequestInner{}
-	createSecurityGroupOpts.Name = d.Get("name").(string)
+	createSecurityGroupOpts.Name = withNamePrefix(m, d.Get("name").(string))
 	createSecurityGroupOpts.SecurityGroupRules = rules
 
 	if metadataRaw, ok := d.GetOk("metadata_map"); ok {
@@ -280,9 +328,14 @@ func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	invalidateSecurityGroupNames(m, clientV2)
 
 	d.SetId(sg.ID)
 
+	if err := createSelfReferencingSecurityGroupRules(ctx, clientV2, sg.ID, selfRules); err != nil {
+		return diag.FromErr(err)
+	}
+
 	resourceSecurityGroupRead(ctx, d, m)
 	log.Printf("[DEBUG] Finish SecurityGroup creating (%s)", sg.ID)
 
@@ -304,8 +357,9 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	d.Set("region_id", sg.RegionID)
+	d.Set("region_name", sg.Region)
 	d.Set("project_id", sg.ProjectID)
-	d.Set("name", sg.Name)
+	d.Set("name", withoutNamePrefix(m, sg.Name))
 	d.Set("description", sg.Description)
 
 	metadataMap := make(map[string]string)
@@ -332,43 +386,9 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, m in
 	newSgRules := make([]interface{}, len(sg.SecurityGroupRules))
 	for i, sgr := range sg.SecurityGroupRules {
 		log.Printf("rules: %+v", sgr)
-		r := make(map[string]interface{})
-		r["id"] = sgr.ID
-		r["direction"] = sgr.Direction.String()
-
-		if sgr.EtherType != nil {
-			r["ethertype"] = sgr.EtherType.String()
-		}
-
-		r["protocol"] = edgecloudV2.SGRuleProtocolANY
-		if sgr.Protocol != nil {
-			r["protocol"] = sgr.Protocol.String()
-		}
-
-		r["port_range_max"] = 65535
-		if sgr.PortRangeMax != nil {
-			r["port_range_max"] = *sgr.PortRangeMax
-		}
-		r["port_range_min"] = 1
-		if sgr.PortRangeMin != nil {
-			r["port_range_min"] = *sgr.PortRangeMin
-		}
-
-		r["description"] = ""
-		if sgr.Description != nil {
-			r["description"] = *sgr.Description
-		}
-
-		r["remote_ip_prefix"] = ""
-		if sgr.RemoteIPPrefix != nil {
-			r["remote_ip_prefix"] = *sgr.RemoteIPPrefix
-		}
-
-		r["updated_at"] = sgr.UpdatedAt
-		r["created_at"] = sgr.CreatedAt
-
-		newSgRules[i] = r
+		newSgRules[i] = flattenSecurityGroupRule(sgr)
 	}
+	keepConfiguredSecurityGroupRuleForm(newSgRules, d.Get("security_group_rules").(*schema.Set).List())
 
 	if err := d.Set("security_group_rules", schema.NewSet(secGroupUniqueID, newSgRules)); err != nil {
 		return diag.FromErr(err)
@@ -402,7 +422,7 @@ func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 	gid := d.Id()
 
 	if d.HasChange("name") {
-		newName := d.Get("name").(string)
+		newName := withNamePrefix(m, d.Get("name").(string))
 		req := &edgecloudV2.SecurityGroupUpdateRequest{
 			Name:         newName,
 			ChangedRules: []edgecloudV2.ChangedRules{},
@@ -411,6 +431,7 @@ func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 		if err != nil {
 			return diag.Errorf("Error updating security group name: %s", err)
 		}
+		invalidateSecurityGroupNames(m, clientV2)
 		log.Printf("[DEBUG] SecurityGroup name updated to: %s", newName)
 	}
 
@@ -492,6 +513,7 @@ func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	invalidateSecurityGroupNames(m, clientV2)
 
 	d.SetId("")
 	log.Printf("[DEBUG] Finish of SecurityGroup deleting")
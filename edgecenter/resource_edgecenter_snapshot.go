@@ -25,6 +25,10 @@ func resourceSnapshot() *schema.Resource {
 		ReadContext:   resourceSnapshotRead,
 		UpdateContext: resourceSnapshotUpdate,
 		DeleteContext: resourceSnapshotDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(snapshotCreatingTimeout),
+			Delete: schema.DefaultTimeout(snapshotDeletingTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				projectID, regionID, snapshotID, err := ImportStringParser(d.Id())
@@ -62,11 +66,12 @@ func resourceSnapshot() *schema.Resource {
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -124,7 +129,7 @@ func resourceSnapshotCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	opts := getSnapshotData(d)
 
-	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Snapshots.Create, opts, clientV2, snapshotCreatingTimeout)
+	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Snapshots.Create, opts, clientV2, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -163,6 +168,7 @@ func resourceSnapshotRead(ctx context.Context, d *schema.ResourceData, m interfa
 	d.Set("size", snapshot.Size)
 	d.Set("volume_id", snapshot.VolumeID)
 	d.Set("region_id", snapshot.RegionID)
+	d.Set("region_name", snapshot.Region)
 	d.Set("project_id", snapshot.ProjectID)
 	if err := d.Set("metadata", snapshot.Metadata); err != nil {
 		return diag.FromErr(err)
@@ -220,7 +226,7 @@ func resourceSnapshotDelete(ctx context.Context, d *schema.ResourceData, m inter
 
 	taskID := results.Tasks[0]
 
-	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, snapshotDeletingTimeout)
+	err = utilV2.WaitForTaskComplete(ctx, clientV2, taskID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
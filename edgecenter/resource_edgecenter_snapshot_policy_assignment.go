@@ -0,0 +1,254 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+const (
+	SnapshotPolicyAssignmentResource = "edgecenter_snapshot_policy_assignment"
+	LifecyclePolicyIDField           = "lifecycle_policy_id"
+	VolumeIDsField                   = "volume_ids"
+)
+
+func resourceSnapshotPolicyAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSnapshotPolicyAssignmentCreate,
+		ReadContext:   resourceSnapshotPolicyAssignmentRead,
+		UpdateContext: resourceSnapshotPolicyAssignmentUpdate,
+		DeleteContext: resourceSnapshotPolicyAssignmentDelete,
+		Description: "Represent the assignment of volumes to a lifecycle policy, which periodically takes snapshots of them. " +
+			"A policy can have many assignments, e.g. one in every module that creates volumes. " +
+			"Each assignment manages only its own volumes, so the policy should not have 'volume' blocks and should ignore their changes with 'lifecycle { ignore_changes = [volume] }'.",
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				projectID, regionID, assignmentID, err := ImportStringParser(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				policyID, volumeIDs, err := parseSnapshotPolicyAssignmentID(assignmentID)
+				if err != nil {
+					return nil, err
+				}
+				d.Set("project_id", projectID)
+				d.Set("region_id", regionID)
+				d.Set(LifecyclePolicyIDField, policyID)
+				d.Set(VolumeIDsField, volumeIDs)
+				d.SetId(assignmentID)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The uuid of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"project_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The name of the project. Either 'project_id' or 'project_name' must be specified.",
+				ExactlyOneOf: []string{"project_id", "project_name"},
+			},
+			"region_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf: []string{"region_id", "region_name"},
+			},
+			"region_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
+			},
+			LifecyclePolicyIDField: {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the lifecycle policy.",
+			},
+			VolumeIDsField: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The IDs of the volumes assigned to the lifecycle policy. The volumes assigned to the policy in another way are not affected.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+		},
+	}
+}
+
+func resourceSnapshotPolicyAssignmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Println("[DEBUG] Start snapshot policy assignment creating")
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyID := d.Get(LifecyclePolicyIDField).(int)
+	volumeIDs := expandSnapshotPolicyAssignmentVolumeIDs(d.Get(VolumeIDsField).(*schema.Set))
+
+	_, _, err = clientV2.LifeCyclePolicies.AddVolumes(ctx, policyID, &edgecloudV2.LifeCyclePolicyAddVolumesRequest{VolumeIds: volumeIDs})
+	if err != nil {
+		return diag.Errorf("Error adding volumes to lifecycle policy %d: %s", policyID, err)
+	}
+
+	d.SetId(snapshotPolicyAssignmentID(policyID, volumeIDs))
+	log.Printf("[DEBUG] Finish snapshot policy assignment creating (%s)", d.Id())
+
+	return resourceSnapshotPolicyAssignmentRead(ctx, d, m)
+}
+
+func resourceSnapshotPolicyAssignmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Start snapshot policy assignment reading (%s)", d.Id())
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setRegionFields(ctx, clientV2, d); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("project_id", clientV2.Project)
+
+	policyID := d.Get(LifecyclePolicyIDField).(int)
+	policy, resp, err := clientV2.LifeCyclePolicies.Get(ctx, policyID, &edgecloudV2.LifeCyclePolicyGetOptions{NeedVolumes: true})
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing snapshot policy assignment %s because lifecycle policy %d doesn't exist anymore", d.Id(), policyID)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error getting lifecycle policy %d: %s", policyID, err)
+	}
+
+	// Only the volumes of this assignment are kept, the volumes detached from the policy outside of Terraform are assigned again on the next apply.
+	assigned := make(map[string]bool, len(policy.Volumes))
+	for _, volume := range policy.Volumes {
+		assigned[volume.ID] = true
+	}
+	volumeIDs := make([]interface{}, 0)
+	for _, volumeID := range d.Get(VolumeIDsField).(*schema.Set).List() {
+		if assigned[volumeID.(string)] {
+			volumeIDs = append(volumeIDs, volumeID)
+		}
+	}
+	if err := d.Set(VolumeIDsField, schema.NewSet(schema.HashString, volumeIDs)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finish snapshot policy assignment reading (%s)", d.Id())
+
+	return nil
+}
+
+func resourceSnapshotPolicyAssignmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Start snapshot policy assignment updating (%s)", d.Id())
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(VolumeIDsField) {
+		policyID := d.Get(LifecyclePolicyIDField).(int)
+		oldRaw, newRaw := d.GetChange(VolumeIDsField)
+		oldVolumes, newVolumes := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+
+		if toRemove := expandSnapshotPolicyAssignmentVolumeIDs(oldVolumes.Difference(newVolumes)); len(toRemove) > 0 {
+			_, _, err = clientV2.LifeCyclePolicies.RemoveVolumes(ctx, policyID, &edgecloudV2.LifeCyclePolicyRemoveVolumesRequest{VolumeIds: toRemove})
+			if err != nil {
+				return diag.Errorf("Error removing volumes from lifecycle policy %d: %s", policyID, err)
+			}
+		}
+		if toAdd := expandSnapshotPolicyAssignmentVolumeIDs(newVolumes.Difference(oldVolumes)); len(toAdd) > 0 {
+			_, _, err = clientV2.LifeCyclePolicies.AddVolumes(ctx, policyID, &edgecloudV2.LifeCyclePolicyAddVolumesRequest{VolumeIds: toAdd})
+			if err != nil {
+				return diag.Errorf("Error adding volumes to lifecycle policy %d: %s", policyID, err)
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Finish snapshot policy assignment updating (%s)", d.Id())
+
+	return resourceSnapshotPolicyAssignmentRead(ctx, d, m)
+}
+
+func resourceSnapshotPolicyAssignmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Start snapshot policy assignment deleting (%s)", d.Id())
+
+	clientV2, err := InitCloudClient(ctx, d, m, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyID := d.Get(LifecyclePolicyIDField).(int)
+	volumeIDs := expandSnapshotPolicyAssignmentVolumeIDs(d.Get(VolumeIDsField).(*schema.Set))
+	if len(volumeIDs) > 0 {
+		_, _, err = clientV2.LifeCyclePolicies.RemoveVolumes(ctx, policyID, &edgecloudV2.LifeCyclePolicyRemoveVolumesRequest{VolumeIds: volumeIDs})
+		if err != nil {
+			return diag.Errorf("Error removing volumes from lifecycle policy %d: %s", policyID, err)
+		}
+	}
+
+	d.SetId("")
+	log.Println("[DEBUG] Finish snapshot policy assignment deleting")
+
+	return nil
+}
+
+func expandSnapshotPolicyAssignmentVolumeIDs(s *schema.Set) []string {
+	volumeIDs := make([]string, 0, s.Len())
+	for _, volumeID := range s.List() {
+		volumeIDs = append(volumeIDs, volumeID.(string))
+	}
+	sort.Strings(volumeIDs)
+
+	return volumeIDs
+}
+
+// snapshotPolicyAssignmentID builds the assignment ID from the policy ID and the volume IDs assigned when it is created.
+func snapshotPolicyAssignmentID(policyID int, volumeIDs []string) string {
+	return fmt.Sprintf("%d/%s", policyID, strings.Join(volumeIDs, ","))
+}
+
+// parseSnapshotPolicyAssignmentID parses the assignment ID in the <lifecycle_policy_id>/<volume_id>,<volume_id> format.
+func parseSnapshotPolicyAssignmentID(id string) (int, []string, error) {
+	policyIDStr, volumeIDsStr, ok := strings.Cut(id, "/")
+	if !ok || volumeIDsStr == "" {
+		return 0, nil, fmt.Errorf("wrong snapshot policy assignment id %s, expected <lifecycle_policy_id>/<volume_id>,<volume_id>", id)
+	}
+	policyID, err := strconv.Atoi(policyIDStr)
+	if err != nil {
+		return 0, nil, fmt.Errorf("wrong lifecycle policy id %s: %w", policyIDStr, err)
+	}
+
+	return policyID, strings.Split(volumeIDsStr, ","), nil
+}
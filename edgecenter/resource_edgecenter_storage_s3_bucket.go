@@ -12,7 +12,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	storageSDK "github.com/Edge-Center/edgecenter-storage-sdk-go"
 	"github.com/Edge-Center/edgecenter-storage-sdk-go/swagger/client/buckets"
+	"github.com/Edge-Center/edgecenter-storage-sdk-go/swagger/client/storages"
+	"github.com/Edge-Center/edgecenter-storage-sdk-go/swagger/models"
 )
 
 const (
@@ -44,6 +47,11 @@ func resourceStorageS3Bucket() *schema.Resource {
 				},
 				Description: "A name of new storage bucket resource.",
 			},
+			StorageSchemaGenerateHTTPEndpoint: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A http entry point of the bucket, can be used as a CDN origin.",
+			},
 		},
 		CreateContext: resourceStorageS3BucketCreate,
 		ReadContext:   resourceStorageS3BucketRead,
@@ -107,6 +115,13 @@ func resourceStorageS3BucketRead(ctx context.Context, d *schema.ResourceData, m
 			d.SetId(fmt.Sprintf("%d:%s", storageID, bucketName))
 			_ = d.Set(StorageS3BucketSchemaStorageID, storageID)
 			_ = d.Set(StorageS3BucketSchemaName, bucketName)
+
+			address, err := storageAddress(ctx, client, storageID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			_ = d.Set(StorageSchemaGenerateHTTPEndpoint, fmt.Sprintf("https://%s/%s", address, bucketName))
+
 			return nil
 		}
 	}
@@ -114,6 +129,34 @@ func resourceStorageS3BucketRead(ctx context.Context, d *schema.ResourceData, m
 	return diag.FromErr(fmt.Errorf("storage buckets list has not this bucket"))
 }
 
+// storageAddress returns the address of the storage with the given id.
+func storageAddress(ctx context.Context, client *storageSDK.SDK, storageID int) (string, error) {
+	storage, err := getStorage(ctx, client, storageID)
+	if err != nil {
+		return "", err
+	}
+
+	return storage.Address, nil
+}
+
+// getStorage returns the storage with the given id.
+func getStorage(ctx context.Context, client *storageSDK.SDK, storageID int) (*models.Storage, error) {
+	resourceID := strconv.Itoa(storageID)
+	opts := []func(opt *storages.StorageListHTTPV2Params){
+		func(opt *storages.StorageListHTTPV2Params) { opt.Context = ctx },
+		func(opt *storages.StorageListHTTPV2Params) { opt.ID = &resourceID },
+	}
+	result, err := client.StoragesList(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("storages list: %w", err)
+	}
+	if len(result) != 1 {
+		return nil, fmt.Errorf("get storage: wrong length of search result (%d), want 1", len(result))
+	}
+
+	return &result[0], nil
+}
+
 func resourceStorageS3BucketDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	storageID, bucketName := storageBucketResourceID(d)
 	log.Printf("[DEBUG] Start S3 Storage Bucket Resource deleting (id=%d,name=%s)\n", storageID, bucketName)
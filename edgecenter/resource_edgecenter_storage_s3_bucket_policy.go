@@ -0,0 +1,126 @@
+package edgecenter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Edge-Center/edgecenter-storage-sdk-go/swagger/client/buckets"
+)
+
+const (
+	StorageS3BucketPolicySchemaStorageID  = "storage_id"
+	StorageS3BucketPolicySchemaBucketName = "bucket_name"
+)
+
+func resourceStorageS3BucketPolicy() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			StorageS3BucketPolicySchemaStorageID: {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "An id of existing storage resource.",
+			},
+			StorageS3BucketPolicySchemaBucketName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A name of existing storage bucket resource.",
+			},
+		},
+		CreateContext: resourceStorageS3BucketPolicyCreate,
+		ReadContext:   resourceStorageS3BucketPolicyRead,
+		DeleteContext: resourceStorageS3BucketPolicyDelete,
+		Description: "Represent s3 storage bucket policy resource. The policy grants public read access " +
+			"to the bucket objects, which is required to serve a bucket as a static website or CDN origin. " +
+			"The storage API doesn't allow to revoke the policy, so deleting this resource only removes it from the state.",
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceStorageS3BucketPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	storageID := d.Get(StorageS3BucketPolicySchemaStorageID).(int)
+	bucketName := strings.TrimSpace(d.Get(StorageS3BucketPolicySchemaBucketName).(string))
+	log.Printf("[DEBUG] Start S3 Storage Bucket Policy Resource creating (id=%d, name=%s)\n", storageID, bucketName)
+	defer log.Println("[DEBUG] Finish S3 Storage Bucket Policy Resource creating")
+
+	config := m.(*Config)
+	client := config.StorageClient
+
+	opts := []func(opt *buckets.StorageBucketPolicyCreateHTTPParams){
+		func(opt *buckets.StorageBucketPolicyCreateHTTPParams) { opt.Context = ctx },
+		func(opt *buckets.StorageBucketPolicyCreateHTTPParams) { opt.ID = int64(storageID) },
+		func(opt *buckets.StorageBucketPolicyCreateHTTPParams) { opt.Name = bucketName },
+	}
+	if err := client.CreateBucketPolicy(opts...); err != nil {
+		return diag.FromErr(fmt.Errorf("create storage bucket policy: %w", err))
+	}
+	d.SetId(fmt.Sprintf("%d:%s", storageID, bucketName))
+
+	return resourceStorageS3BucketPolicyRead(ctx, d, m)
+}
+
+func resourceStorageS3BucketPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	storageID, bucketName := storageBucketPolicyResourceID(d)
+	log.Printf("[DEBUG] Start S3 Storage Bucket Policy Resource reading (id=%d, name=%s)\n", storageID, bucketName)
+	defer log.Println("[DEBUG] Finish S3 Storage Bucket Policy Resource reading")
+
+	config := m.(*Config)
+	client := config.StorageClient
+
+	opts := []func(opt *buckets.StorageListBucketsHTTPParams){
+		func(opt *buckets.StorageListBucketsHTTPParams) { opt.Context = ctx },
+		func(opt *buckets.StorageListBucketsHTTPParams) { opt.ID = int64(storageID) },
+	}
+
+	result, err := client.BucketsList(opts...)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("storage buckets list: %w", err))
+	}
+	for _, bucket := range result {
+		if bucket.Name == bucketName {
+			_ = d.Set(StorageS3BucketPolicySchemaStorageID, storageID)
+			_ = d.Set(StorageS3BucketPolicySchemaBucketName, bucketName)
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] Removing bucket policy %s because bucket doesn't exist anymore", d.Id())
+	d.SetId("")
+
+	return nil
+}
+
+func resourceStorageS3BucketPolicyDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	storageID, bucketName := storageBucketPolicyResourceID(d)
+	log.Printf("[DEBUG] Start S3 Storage Bucket Policy Resource deleting (id=%d, name=%s)\n", storageID, bucketName)
+	defer log.Println("[DEBUG] Finish S3 Storage Bucket Policy Resource deleting")
+
+	d.SetId("")
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Bucket policy is not revoked",
+			Detail: fmt.Sprintf("The storage API doesn't support bucket policy removal, bucket %q of storage %d "+
+				"is still publicly readable. Delete the bucket to revoke public access.", bucketName, storageID),
+		},
+	}
+}
+
+func storageBucketPolicyResourceID(d *schema.ResourceData) (int, string) {
+	if d.Id() == "" {
+		storageID := d.Get(StorageS3BucketPolicySchemaStorageID).(int)
+		bucketName := strings.TrimSpace(d.Get(StorageS3BucketPolicySchemaBucketName).(string))
+		return storageID, bucketName
+	}
+
+	return storageBucketResourceID(d)
+}
@@ -0,0 +1,310 @@
+package edgecenter
+
+import (
+	"context"
+	"crypto/md5" //nolint: gosec
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	StorageS3ObjectSchemaStorageID     = "storage_id"
+	StorageS3ObjectSchemaBucketName    = "bucket_name"
+	StorageS3ObjectSchemaKey           = "key"
+	StorageS3ObjectSchemaAccessKey     = "access_key"
+	StorageS3ObjectSchemaSecretKey     = "secret_key"
+	StorageS3ObjectSchemaContent       = "content"
+	StorageS3ObjectSchemaContentBase64 = "content_base64"
+	StorageS3ObjectSchemaSource        = "source"
+	StorageS3ObjectSchemaContentType   = "content_type"
+	StorageS3ObjectSchemaCacheControl  = "cache_control"
+	StorageS3ObjectSchemaETag          = "etag"
+)
+
+var storageS3ObjectContentFields = []string{
+	StorageS3ObjectSchemaContent,
+	StorageS3ObjectSchemaContentBase64,
+	StorageS3ObjectSchemaSource,
+}
+
+func resourceStorageS3Object() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			StorageS3ObjectSchemaStorageID: {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "An id of existing storage resource.",
+			},
+			StorageS3ObjectSchemaBucketName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A name of existing storage bucket resource.",
+			},
+			StorageS3ObjectSchemaKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "A key of the object in the bucket, e.g. 'scripts/bootstrap.sh'.",
+			},
+			StorageS3ObjectSchemaAccessKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "A s3 access key of the storage, e.g. 'generated_access_key' of the storage resource.",
+			},
+			StorageS3ObjectSchemaSecretKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "A s3 secret key of the storage, e.g. 'generated_secret_key' of the storage resource.",
+			},
+			StorageS3ObjectSchemaContent: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: storageS3ObjectContentFields,
+				Description:  "A content of the object as UTF-8 string. Either 'content', 'content_base64' or 'source' must be specified.",
+			},
+			StorageS3ObjectSchemaContentBase64: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: storageS3ObjectContentFields,
+				ValidateFunc: validation.StringIsBase64,
+				Description:  "A content of the object as base64-encoded binary data. Either 'content', 'content_base64' or 'source' must be specified.",
+			},
+			StorageS3ObjectSchemaSource: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: storageS3ObjectContentFields,
+				Description:  "A path to the file to be uploaded as the object. Either 'content', 'content_base64' or 'source' must be specified.",
+			},
+			StorageS3ObjectSchemaContentType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "A MIME type of the object, e.g. 'text/html'. By default, it is defined by the storage.",
+			},
+			StorageS3ObjectSchemaCacheControl: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A Cache-Control header of the object, e.g. 'max-age=3600'.",
+			},
+			StorageS3ObjectSchemaETag: {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "The MD5 hash of the object content. The object is uploaded again when the content " +
+					"of the configuration or the file differs from the object in the bucket.",
+			},
+		},
+		CreateContext: resourceStorageS3ObjectCreate,
+		ReadContext:   resourceStorageS3ObjectRead,
+		UpdateContext: resourceStorageS3ObjectUpdate,
+		DeleteContext: resourceStorageS3ObjectDelete,
+		CustomizeDiff: resourceStorageS3ObjectCustomizeDiff,
+		Description: "Represent s3 storage object resource. It is intended for small files, " +
+			"e.g. bootstrap scripts or static error pages, the content is read to the memory and uploaded in a single request.",
+	}
+}
+
+func resourceStorageS3ObjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	storageID := d.Get(StorageS3ObjectSchemaStorageID).(int)
+	bucketName := d.Get(StorageS3ObjectSchemaBucketName).(string)
+	key := d.Get(StorageS3ObjectSchemaKey).(string)
+	log.Printf("[DEBUG] Start S3 Storage Object Resource creating (id=%d, bucket=%s, key=%s)\n", storageID, bucketName, key)
+	defer log.Println("[DEBUG] Finish S3 Storage Object Resource creating")
+
+	if err := storageS3ObjectUpload(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%d:%s:%s", storageID, bucketName, key))
+
+	return resourceStorageS3ObjectRead(ctx, d, m)
+}
+
+func resourceStorageS3ObjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	storageID, bucketName, key, err := storageS3ObjectResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] Start S3 Storage Object Resource reading (id=%d, bucket=%s, key=%s)\n", storageID, bucketName, key)
+	defer log.Println("[DEBUG] Finish S3 Storage Object Resource reading")
+
+	client, err := storageS3ObjectClient(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.Do(ctx, http.MethodHead, bucketName, key, nil, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Removing S3 Storage Object %s because it doesn't exist anymore", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("get storage object: %w", err))
+	}
+	resp.Body.Close()
+
+	_ = d.Set(StorageS3ObjectSchemaStorageID, storageID)
+	_ = d.Set(StorageS3ObjectSchemaBucketName, bucketName)
+	_ = d.Set(StorageS3ObjectSchemaKey, key)
+	_ = d.Set(StorageS3ObjectSchemaETag, strings.Trim(resp.Header.Get("ETag"), `"`))
+	_ = d.Set(StorageS3ObjectSchemaContentType, resp.Header.Get("Content-Type"))
+	_ = d.Set(StorageS3ObjectSchemaCacheControl, resp.Header.Get("Cache-Control"))
+
+	return nil
+}
+
+func resourceStorageS3ObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] Start S3 Storage Object Resource updating (id=%s)\n", d.Id())
+	defer log.Println("[DEBUG] Finish S3 Storage Object Resource updating")
+
+	// The object is replaced as a whole, the storage doesn't allow to change only the headers of it.
+	if d.HasChanges(append([]string{StorageS3ObjectSchemaContentType, StorageS3ObjectSchemaCacheControl, StorageS3ObjectSchemaETag},
+		storageS3ObjectContentFields...)...) {
+		if err := storageS3ObjectUpload(ctx, d, m); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceStorageS3ObjectRead(ctx, d, m)
+}
+
+func resourceStorageS3ObjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, bucketName, key, err := storageS3ObjectResourceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] Start S3 Storage Object Resource deleting (id=%s)\n", d.Id())
+	defer log.Println("[DEBUG] Finish S3 Storage Object Resource deleting")
+
+	client, err := storageS3ObjectClient(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.Do(ctx, http.MethodDelete, bucketName, key, nil, nil)
+	if err != nil {
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return diag.FromErr(fmt.Errorf("delete storage object: %w", err))
+		}
+	} else {
+		resp.Body.Close()
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceStorageS3ObjectCustomizeDiff plans the upload of the object when the MD5 hash of the content
+// differs from the etag of the object, e.g. the source file or the object in the bucket is changed.
+func resourceStorageS3ObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	for _, field := range storageS3ObjectContentFields {
+		if !d.NewValueKnown(field) {
+			return d.SetNewComputed(StorageS3ObjectSchemaETag)
+		}
+	}
+
+	content, err := storageS3ObjectContent(d)
+	if err != nil {
+		log.Printf("[WARN] Cannot compare the content of S3 Storage Object %s: %s", d.Id(), err)
+		return d.SetNewComputed(StorageS3ObjectSchemaETag)
+	}
+	if storageS3ObjectETag(content) != d.Get(StorageS3ObjectSchemaETag).(string) {
+		return d.SetNewComputed(StorageS3ObjectSchemaETag)
+	}
+
+	return nil
+}
+
+func storageS3ObjectUpload(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	content, err := storageS3ObjectContent(d)
+	if err != nil {
+		return err
+	}
+	client, err := storageS3ObjectClient(ctx, d, m)
+	if err != nil {
+		return err
+	}
+
+	headers := map[string]string{
+		"Content-Type":  d.Get(StorageS3ObjectSchemaContentType).(string),
+		"Cache-Control": d.Get(StorageS3ObjectSchemaCacheControl).(string),
+	}
+	resp, err := client.Do(ctx, http.MethodPut,
+		d.Get(StorageS3ObjectSchemaBucketName).(string), d.Get(StorageS3ObjectSchemaKey).(string), content, headers)
+	if err != nil {
+		return fmt.Errorf("upload storage object: %w", err)
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+func storageS3ObjectClient(ctx context.Context, d *schema.ResourceData, m interface{}) (*storageS3Client, error) {
+	config := m.(*Config)
+	storage, err := getStorage(ctx, config.StorageClient, d.Get(StorageS3ObjectSchemaStorageID).(int))
+	if err != nil {
+		return nil, err
+	}
+
+	return newStorageS3Client(storage.Address, storageS3SigningRegion(storage.Location),
+		d.Get(StorageS3ObjectSchemaAccessKey).(string), d.Get(StorageS3ObjectSchemaSecretKey).(string)), nil
+}
+
+// storageS3ObjectContent returns the content of the object from one of the content fields.
+func storageS3ObjectContent(d interface{ Get(string) interface{} }) ([]byte, error) {
+	if source := d.Get(StorageS3ObjectSchemaSource).(string); source != "" {
+		content, err := os.ReadFile(source)
+		if err != nil {
+			return nil, fmt.Errorf("read source file: %w", err)
+		}
+		return content, nil
+	}
+	if encoded := d.Get(StorageS3ObjectSchemaContentBase64).(string); encoded != "" {
+		content, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("decode content_base64: %w", err)
+		}
+		return content, nil
+	}
+
+	return []byte(d.Get(StorageS3ObjectSchemaContent).(string)), nil
+}
+
+// storageS3ObjectETag returns the etag of the object uploaded in a single request.
+func storageS3ObjectETag(content []byte) string {
+	sum := md5.Sum(content) //nolint: gosec
+	return hex.EncodeToString(sum[:])
+}
+
+// storageS3ObjectResourceID parses the resource ID in the <storage_id>:<bucket_name>:<key> format.
+func storageS3ObjectResourceID(id string) (int, string, string, error) {
+	parts := strings.SplitN(id, ":", 3)
+	if len(parts) != 3 {
+		return 0, "", "", errors.New("wrong storage object id, expected <storage_id>:<bucket_name>:<key>")
+	}
+	storageID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, "", "", fmt.Errorf("wrong storage id %s: %w", parts[0], err)
+	}
+
+	return storageID, parts[1], parts[2], nil
+}
@@ -6,7 +6,6 @@ import (
 	"log"
 	"net"
 	"net/http"
-	"regexp"
 	"time"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -67,11 +66,12 @@ func resourceSubnet() *schema.Resource {
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -87,7 +87,12 @@ func resourceSubnet() *schema.Resource {
 			"cidr": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Represents the IP address range of the subnet.",
+				Description: "Represents the IP address range of the subnet. Both IPv4 and IPv6 CIDRs are accepted.",
+			},
+			"ip_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "IP version of the subnet, 4 or 6.",
 			},
 			"network_id": {
 				Type:        schema.TypeString,
@@ -122,7 +127,7 @@ func resourceSubnet() *schema.Resource {
 						"nexthop": {
 							Type:        schema.TypeString,
 							Required:    true,
-							Description: "IPv4 address to forward traffic to if it's destination IP matches 'destination' CIDR",
+							Description: "IP address to forward traffic to if it's destination IP matches 'destination' CIDR",
 						},
 					},
 				},
@@ -134,8 +139,7 @@ func resourceSubnet() *schema.Resource {
 				Description: "The IP address of the gateway for this subnet.",
 				ValidateDiagFunc: func(val interface{}, key cty.Path) diag.Diagnostics {
 					v := val.(string)
-					IP := regexp.MustCompile(`(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)(\.(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)){3}`)
-					if v == disable || IP.MatchString(v) {
+					if v == disable || net.ParseIP(v) != nil {
 						return nil
 					}
 					return diag.FromErr(fmt.Errorf("%q must be a valid ip, got: %s", key, v))
@@ -240,6 +244,7 @@ func resourceSubnetCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		}
 		createOpts.Metadata = *meta
 	}
+	createOpts.Metadata = withMetadataStamp(m, createOpts.Metadata)
 
 	log.Printf("Create subnet ops: %+v", createOpts)
 
@@ -295,8 +300,10 @@ func resourceSubnetRead(ctx context.Context, d *schema.ResourceData, m interface
 	}
 	d.Set("host_routes", hrs)
 	d.Set("region_id", subnet.RegionID)
+	d.Set("region_name", subnet.Region)
 	d.Set("project_id", subnet.ProjectID)
 	d.Set("gateway_ip", subnet.GatewayIP.String())
+	d.Set("ip_version", subnet.IPVersion)
 
 	fields := []string{"connect_to_network_router"}
 	revertState(d, &fields)
@@ -307,6 +314,7 @@ func resourceSubnetRead(ctx context.Context, d *schema.ResourceData, m interface
 	}
 
 	metadataMap, metadataReadOnly := PrepareMetadata(subnet.Metadata)
+	metadataMap = withoutMetadataStamp(m, d, metadataMap)
 
 	if err = d.Set("metadata_map", metadataMap); err != nil {
 		return diag.FromErr(err)
@@ -380,7 +388,7 @@ func resourceSubnetUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 			return diag.Errorf("metadata wrong fmt. Error: %s", err)
 		}
 
-		metaSubnet := edgecloudV2.Metadata(*meta)
+		metaSubnet := edgecloudV2.Metadata(withMetadataStamp(m, *meta))
 
 		_, err = clientV2.Subnetworks.MetadataUpdate(ctx, subnetID, &metaSubnet)
 		if err != nil {
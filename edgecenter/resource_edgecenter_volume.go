@@ -19,6 +19,7 @@ const (
 	VolumeCreatingTimeout  = 1200 * time.Second
 	volumeExtendingTimeout = 1200 * time.Second
 	VolumesPoint           = "volumes"
+	VolumeAttachedState    = "attached"
 )
 
 func resourceVolume() *schema.Resource {
@@ -27,8 +28,14 @@ func resourceVolume() *schema.Resource {
 		ReadContext:   resourceVolumeRead,
 		UpdateContext: resourceVolumeUpdate,
 		DeleteContext: resourceVolumeDelete,
+		CustomizeDiff: resourceVolumeCustomizeDiff,
 		Description: `A volume is a detachable block storage device akin to a USB hard drive or SSD, but located remotely in the cloud.
 Volumes can be attached to a virtual machine and manipulated like a physical hard drive.`,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(VolumeCreatingTimeout),
+			Update: schema.DefaultTimeout(volumeExtendingTimeout),
+			Delete: schema.DefaultTimeout(volumeDeletingTimeout),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 				projectID, regionID, volumeID, err := ImportStringParser(d.Id())
@@ -74,14 +81,17 @@ Volumes can be attached to a virtual machine and manipulated like a physical har
 			"region_id": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				Description:  "The uuid of the region. Either 'region_id' or 'region_name' must be specified.",
 				ExactlyOneOf: []string{"region_id", "region_name"},
 			},
 			"region_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The name of the region. Either 'region_id' or 'region_name' must be specified.",
-				ExactlyOneOf: []string{"region_id", "region_name"},
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The name of the region. Either 'region_id' or 'region_name' must be specified.",
+				ExactlyOneOf:     []string{"region_id", "region_name"},
+				DiffSuppressFunc: suppressRegionNameDiffs,
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -117,6 +127,15 @@ Volumes can be attached to a virtual machine and manipulated like a physical har
 				Description:   "(ForceNew) The ID of the snapshot to create the volume from. This field is mandatory if creating a volume from a snapshot.",
 				ConflictsWith: []string{"size", "type_name"},
 			},
+			"attached_instance_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Description: "A set of instance ids the volume is attached to. More than one instance can be specified only if the volume type supports multiattach, e.g. for clustered filesystems. " +
+					"The instances removed from the set are detached, an empty set detaches the volume from all the instances. " +
+					"If the field is not set, the attachments are not managed, so the volume can be attached with the instance resources.",
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"last_updated": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -170,8 +189,9 @@ func resourceVolumeCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	opts.Metadata = withMetadataStamp(m, opts.Metadata)
 
-	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Volumes.Create, opts, clientV2, VolumeCreatingTimeout)
+	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, clientV2.Volumes.Create, opts, clientV2, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.Errorf("error creating volume: %s", err)
 	}
@@ -181,6 +201,13 @@ func resourceVolumeCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	log.Printf("[DEBUG] Volume id (%s)", VolumeID)
 
 	d.SetId(VolumeID)
+
+	for _, instanceID := range d.Get("attached_instance_ids").(*schema.Set).List() {
+		if err = attachVolumeToInstance(ctx, clientV2, VolumeID, instanceID.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	resourceVolumeRead(ctx, d, m)
 
 	log.Printf("[DEBUG] Finish volume creating (%s)", VolumeID)
@@ -210,9 +237,19 @@ func resourceVolumeRead(ctx context.Context, d *schema.ResourceData, m interface
 	d.Set("size", volume.Size)
 	d.Set("type_name", volume.VolumeType)
 	d.Set("region_id", volume.RegionID)
+	d.Set("region_name", volume.Region)
 	d.Set("project_id", volume.ProjectID)
 
+	attachedInstanceIDs := make([]string, 0, len(volume.Attachments))
+	for _, attachment := range volume.Attachments {
+		attachedInstanceIDs = append(attachedInstanceIDs, attachment.ServerID)
+	}
+	if err = d.Set("attached_instance_ids", attachedInstanceIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
 	metadataMap, metadataReadOnly := PrepareMetadata(volume.Metadata)
+	metadataMap = withoutMetadataStamp(m, d, metadataMap)
 
 	if err = d.Set("metadata_map", metadataMap); err != nil {
 		return diag.FromErr(err)
@@ -259,7 +296,7 @@ func resourceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 			return diag.FromErr(err)
 		}
 
-		if err = utilV2.WaitForTaskComplete(ctx, clientV2, task.Tasks[0], volumeExtendingTimeout); err != nil {
+		if err = utilV2.WaitForTaskComplete(ctx, clientV2, task.Tasks[0], d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -291,13 +328,30 @@ func resourceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		if err != nil {
 			return diag.Errorf("cannot get metadata. Error: %s", err)
 		}
-		metadataUpdate := edgecloudV2.Metadata(*metadata)
+		metadataUpdate := edgecloudV2.Metadata(withMetadataStamp(m, *metadata))
 
 		if _, err := clientV2.Volumes.MetadataUpdate(ctx, d.Id(), &metadataUpdate); err != nil {
 			return diag.Errorf("cannot update metadata. Error: %s", err)
 		}
 	}
 
+	if d.HasChange("attached_instance_ids") {
+		oldIDsRaw, newIDsRaw := d.GetChange("attached_instance_ids")
+		oldIDs, newIDs := oldIDsRaw.(*schema.Set), newIDsRaw.(*schema.Set)
+
+		for _, instanceID := range oldIDs.Difference(newIDs).List() {
+			if err := detachVolumeFromInstance(ctx, clientV2, volumeID, instanceID.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		for _, instanceID := range newIDs.Difference(oldIDs).List() {
+			if err := attachVolumeToInstance(ctx, clientV2, volumeID, instanceID.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	d.Set("last_updated", time.Now().Format(time.RFC850))
 	log.Println("[DEBUG] Finish volume updating")
 
@@ -320,15 +374,20 @@ func resourceVolumeDelete(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.Errorf("Error getting volume: %s", err)
 	}
 
-	if len(volume.Attachments) > 0 {
-		volumeDetachRequest := &edgecloudV2.VolumeDetachRequest{InstanceID: volume.Attachments[0].ServerID}
-		if _, _, err = clientV2.Volumes.Detach(ctx, d.Id(), volumeDetachRequest); err != nil {
-			return diag.Errorf("Error detaching volume from instance: %s", err)
+	config := m.(*Config)
+	if config.Features.DetachVolumesBeforeDelete {
+		for _, attachment := range volume.Attachments {
+			volumeDetachRequest := &edgecloudV2.VolumeDetachRequest{InstanceID: attachment.ServerID}
+			if _, _, err = clientV2.Volumes.Detach(ctx, d.Id(), volumeDetachRequest); err != nil {
+				return diag.Errorf("Error detaching volume from instance: %s", err)
+			}
 		}
+	} else if len(volume.Attachments) > 0 {
+		return diag.Errorf("volume %s is attached to %d instance(s), detach it first or enable the detach_volumes_before_delete feature", volumeID, len(volume.Attachments))
 	}
 
 	log.Printf("[INFO] Deleting volume: %s", d.Id())
-	if err = utilV2.DeleteResourceIfExist(ctx, clientV2, clientV2.Volumes, d.Id(), volumeDeletingTimeout); err != nil {
+	if err = utilV2.DeleteResourceIfExist(ctx, clientV2, clientV2.Volumes, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return diag.Errorf("Error deleting volume: %s", err)
 	}
 	d.SetId("")
@@ -336,6 +395,79 @@ func resourceVolumeDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	return nil
 }
 
+// attachVolumeToInstance attaches the volume to the instance and waits until the attachment appears.
+func attachVolumeToInstance(ctx context.Context, client *edgecloudV2.Client, volumeID, instanceID string, timeout time.Duration) error {
+	if _, _, err := client.Volumes.Attach(ctx, volumeID, &edgecloudV2.VolumeAttachRequest{InstanceID: instanceID}); err != nil {
+		return fmt.Errorf("cannot attach volume %s to instance %s: %w", volumeID, instanceID, err)
+	}
+
+	fetch := volumeAttachmentStateRefreshFunc(ctx, client, volumeID, instanceID)
+	if _, err := WaitForResourceStatus(ctx, fetch, []string{VolumeAttachedState}, nil, timeout, 2*time.Second); err != nil {
+		return fmt.Errorf("error waiting for volume (%s) to become attached: %w", volumeID, err)
+	}
+
+	return nil
+}
+
+// resourceVolumeCustomizeDiff plans attached_instance_ids as it is set in the configuration. The field is computed,
+// so without it an empty set in the configuration would keep the attachments of the state and never detach the volume.
+func resourceVolumeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute("attached_instance_ids") {
+		return nil
+	}
+	rawIDs := config.GetAttr("attached_instance_ids")
+	if rawIDs.IsNull() || !rawIDs.IsWhollyKnown() {
+		return nil
+	}
+
+	ids := make([]interface{}, 0, rawIDs.LengthInt())
+	for it := rawIDs.ElementIterator(); it.Next(); {
+		_, id := it.Element()
+		ids = append(ids, id.AsString())
+	}
+	configured := schema.NewSet(schema.HashString, ids)
+	if old, _ := d.GetChange("attached_instance_ids"); old.(*schema.Set).Equal(configured) {
+		return nil
+	}
+
+	return d.SetNew("attached_instance_ids", configured)
+}
+
+// detachVolumeFromInstance detaches the volume from the instance and waits until the attachment is gone.
+// The volume attached to other instances stays in-use.
+func detachVolumeFromInstance(ctx context.Context, client *edgecloudV2.Client, volumeID, instanceID string, timeout time.Duration) error {
+	if _, _, err := client.Volumes.Detach(ctx, volumeID, &edgecloudV2.VolumeDetachRequest{InstanceID: instanceID}); err != nil {
+		return fmt.Errorf("cannot detach volume %s from instance %s: %w", volumeID, instanceID, err)
+	}
+
+	fetch := volumeAttachmentStateRefreshFunc(ctx, client, volumeID, instanceID)
+	pending := []string{VolumeAttachedState, "detaching"}
+	if _, err := WaitForResourceStatus(ctx, fetch, []string{"available", "in-use"}, pending, timeout, 2*time.Second); err != nil {
+		return fmt.Errorf("error waiting for volume (%s) to become detached: %w", volumeID, err)
+	}
+
+	return nil
+}
+
+// volumeAttachmentStateRefreshFunc unlike VolumeV2StateRefreshFuncV2 looks through all attachments of the volume,
+// so it can be used for volumes attached to multiple instances.
+func volumeAttachmentStateRefreshFunc(ctx context.Context, client *edgecloudV2.Client, volumeID, instanceID string) ResourceStatusFetchFunc[*edgecloudV2.Volume, string] {
+	return func() (*edgecloudV2.Volume, string, error) {
+		volume, _, err := client.Volumes.Get(ctx, volumeID)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, attachment := range volume.Attachments {
+			if attachment.ServerID == instanceID {
+				return volume, VolumeAttachedState, nil
+			}
+		}
+
+		return volume, volume.Status, nil
+	}
+}
+
 func getVolumeDataV2(ctx context.Context, d *schema.ResourceData, clientV2 *edgecloudV2.Client) (*edgecloudV2.VolumeCreateRequest, error) {
 	volumeData := edgecloudV2.VolumeCreateRequest{
 		Name:     d.Get("name").(string),
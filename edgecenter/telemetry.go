@@ -0,0 +1,164 @@
+package edgecenter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const telemetryProviderKey = "provider"
+
+type telemetryKey struct{}
+
+// apiCallStats is the summary of the API calls made by one operation of a resource type.
+type apiCallStats struct {
+	Operations   int   `json:"operations"`
+	Calls        int   `json:"api_calls"`
+	TotalLatency int64 `json:"total_latency_ms"`
+	MaxLatency   int64 `json:"max_latency_ms"`
+}
+
+// apiTelemetry counts the cloud API calls made by each resource operation, e.g. 'edgecenter_instance.read',
+// and writes the summary to a file after every operation.
+// Terraform starts a provider process per command, e.g. for plan and apply, so every process writes
+// its own summary to a file named after the configured path and the process ID, see telemetryProcessFile.
+// The processes never share a file, so they need no lock and the file does not grow across the commands.
+type apiTelemetry struct {
+	mu    sync.Mutex
+	path  string
+	stats map[string]*apiCallStats
+}
+
+func newAPITelemetry(path string) *apiTelemetry {
+	return &apiTelemetry{path: telemetryProcessFile(path, os.Getpid()), stats: make(map[string]*apiCallStats)}
+}
+
+// telemetryProcessFile returns the summary file of the process, the process ID is added before the extension,
+// e.g. 'telemetry.1234.json' for 'telemetry.json'.
+func telemetryProcessFile(path string, pid int) string {
+	ext := filepath.Ext(path)
+
+	return fmt.Sprintf("%s.%d%s", strings.TrimSuffix(path, ext), pid, ext)
+}
+
+func (t *apiTelemetry) statsFor(key string) *apiCallStats {
+	s, ok := t.stats[key]
+	if !ok {
+		s = &apiCallStats{}
+		t.stats[key] = s
+	}
+
+	return s
+}
+
+func (t *apiTelemetry) recordCall(key string, latency time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s := t.statsFor(key)
+	s.Calls++
+	s.TotalLatency += latency.Milliseconds()
+	if latency.Milliseconds() > s.MaxLatency {
+		s.MaxLatency = latency.Milliseconds()
+	}
+}
+
+func (t *apiTelemetry) recordOperation(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.statsFor(key).Operations++
+	if err := t.write(); err != nil {
+		log.Printf("[WARN] Cannot write API telemetry to %s: %s", t.path, err)
+	}
+}
+
+// write replaces the summary file of the process with a rename, so that it is never read half-written.
+// It must be called with the lock held.
+func (t *apiTelemetry) write() error {
+	data, err := json.MarshalIndent(t.stats, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(t.path), filepath.Base(t.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), t.path)
+}
+
+// transport wraps the HTTP transport of the cloud client to record the calls.
+func (t *apiTelemetry) transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &telemetryTransport{base: base, telemetry: t}
+}
+
+type telemetryTransport struct {
+	base      http.RoundTripper
+	telemetry *apiTelemetry
+}
+
+func (tt *telemetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key, ok := req.Context().Value(telemetryKey{}).(string)
+	if !ok {
+		key = telemetryProviderKey
+	}
+
+	start := time.Now()
+	resp, err := tt.base.RoundTrip(req)
+	tt.telemetry.recordCall(key, time.Since(start))
+
+	return resp, err
+}
+
+// instrumentResource wraps the CRUD functions of the resource, so that the API calls made by them
+// are recorded under the resource type and the operation when the telemetry is enabled.
+func instrumentResource(resourceType string, r *schema.Resource) {
+	wrap := func(operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		key := resourceType + "." + operation
+
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			config, ok := m.(*Config)
+			if !ok || config.Telemetry == nil {
+				return f(ctx, d, m)
+			}
+
+			diags := f(context.WithValue(ctx, telemetryKey{}, key), d, m)
+			config.Telemetry.recordOperation(key)
+
+			return diags
+		}
+	}
+
+	r.CreateContext = wrap("create", r.CreateContext)
+	r.ReadContext = wrap("read", r.ReadContext)
+	r.UpdateContext = wrap("update", r.UpdateContext)
+	r.DeleteContext = wrap("delete", r.DeleteContext)
+}
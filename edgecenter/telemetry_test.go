@@ -0,0 +1,58 @@
+package edgecenter
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTelemetryProcessFile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "telemetry.json", want: "telemetry.1234.json"},
+		{path: "/tmp/out/telemetry.json", want: "/tmp/out/telemetry.1234.json"},
+		{path: "telemetry", want: "telemetry.1234"},
+	}
+	for _, tt := range tests {
+		if got := telemetryProcessFile(tt.path, 1234); got != tt.want {
+			t.Errorf("telemetryProcessFile(%q, 1234) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestAPITelemetryWritesProcessSummary(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	telemetry := newAPITelemetry(filepath.Join(dir, "telemetry.json"))
+	telemetry.recordCall("edgecenter_instance.read", 20*time.Millisecond)
+	telemetry.recordCall("edgecenter_instance.read", 40*time.Millisecond)
+	telemetry.recordOperation("edgecenter_instance.read")
+
+	data, err := os.ReadFile(telemetryProcessFile(filepath.Join(dir, "telemetry.json"), os.Getpid()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var summary map[string]apiCallStats
+	if err := json.Unmarshal(data, &summary); err != nil {
+		t.Fatal(err)
+	}
+	want := apiCallStats{Operations: 1, Calls: 2, TotalLatency: 60, MaxLatency: 40}
+	if got := summary["edgecenter_instance.read"]; got != want {
+		t.Errorf("summary = %+v, want %+v", got, want)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("got %d files in %s, want only the summary of the process", len(entries), dir)
+	}
+}
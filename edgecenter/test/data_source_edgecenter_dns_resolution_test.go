@@ -0,0 +1,65 @@
+//go:build dns
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
+)
+
+func TestAccDnsResolutionDataSource(t *testing.T) {
+	t.Parallel()
+	random := time.Now().Nanosecond()
+	domain := "terraformtest"
+	subDomain := fmt.Sprintf("resolution%d", random)
+	name := fmt.Sprintf("%s_%s", subDomain, domain)
+	zone := domain + ".com"
+	fullDomain := subDomain + "." + zone
+
+	recordName := fmt.Sprintf("%s.%s", edgecenter.DNSZoneRecordResource, name)
+	dataSourceName := fmt.Sprintf("data.%s.%s", edgecenter.DNSResolutionDataSource, name)
+
+	template := fmt.Sprintf(`
+resource "%[1]s" "%[2]s" {
+  zone = "%[3]s"
+  domain = "%[4]s"
+  type = "A"
+  ttl = 120
+
+  resource_record {
+    content  = "127.0.0.1"
+  }
+}
+
+data "%[5]s" "%[2]s" {
+  zone = %[1]s.%[2]s.zone
+  domain = %[1]s.%[2]s.domain
+  type = %[1]s.%[2]s.type
+  nameserver = "%[6]s"
+}
+	`, edgecenter.DNSZoneRecordResource, name, zone, fullDomain, edgecenter.DNSResolutionDataSource, EC_DNS_NAMESERVER)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckVars(t, EC_USERNAME_VAR, EC_PASSWORD_VAR, EC_DNS_URL_VAR, EC_DNS_NAMESERVER_VAR)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: template,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(recordName),
+					resource.TestCheckResourceAttr(dataSourceName, edgecenter.DNSResolutionSchemaNameserver, EC_DNS_NAMESERVER),
+					resource.TestCheckResourceAttr(dataSourceName, edgecenter.DNSResolutionSchemaValues+".#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, edgecenter.DNSResolutionSchemaValues+".0", "127.0.0.1"),
+					resource.TestCheckResourceAttr(dataSourceName, edgecenter.DNSZoneRecordSchemaTTL, "120"),
+				),
+			},
+		},
+	})
+}
@@ -76,6 +76,7 @@ func TestAccFloatingIPDataSource(t *testing.T) {
 					testAccCheckResourceExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "id", floatingIPID.(string)),
 					resource.TestCheckResourceAttr(resourceName, "floating_ip_address", fip.FloatingIPAddress.String()),
+					resource.TestCheckResourceAttr(resourceName, "attached", "false"),
 				),
 			},
 		},
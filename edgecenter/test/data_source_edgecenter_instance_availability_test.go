@@ -0,0 +1,38 @@
+//go:build cloud_data_source
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccInstanceAvailabilityDataSource(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "data.edgecenter_instance_availability.acctest"
+	tpl := fmt.Sprintf(`
+	data "edgecenter_instance_availability" "acctest" {
+	  %s
+      %s
+	  flavor_id = "%s"
+	}
+	`, projectInfo(), regionInfo(), flavorTest)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: tpl,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "available"),
+					resource.TestCheckResourceAttr(resourceName, "baremetal", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,36 @@
+//go:build cloud_data_source
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccK8sVersionsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "data.edgecenter_k8s_versions.acctest"
+	tpl := fmt.Sprintf(`
+	data "edgecenter_k8s_versions" "acctest" {
+	  %s
+      %s
+	}
+	`, projectInfo(), regionInfo())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: tpl,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "versions.0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,37 @@
+//go:build cloud_data_source
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBFlavorDataSource(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "data.edgecenter_lbflavor.acctest"
+	tpl := fmt.Sprintf(`
+	data "edgecenter_lbflavor" "acctest" {
+	  %s
+      %s
+	  min_vcpus = 1
+	}
+	`, projectInfo(), regionInfo())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: tpl,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "flavor_name"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,53 @@
+//go:build cloud_data_source
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccLBMemberDataSource(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test in short mode")
+	}
+
+	resourceName := "data.edgecenter_lbmember.acctest"
+	tpl := fmt.Sprintf(`
+		resource "edgecenter_lbmember" "acctest" {
+		  %s
+		  %s
+		  pool_id = "%s"
+		  address = "10.10.2.15"
+		  protocol_port = 8080
+		}
+
+		data "edgecenter_lbmember" "acctest" {
+		  %s
+		  %s
+		  pool_id = "%s"
+		  member_id = edgecenter_lbmember.acctest.id
+		}
+	`, projectInfo(), regionInfo(), EC_LBPOOL_ID, projectInfo(), regionInfo(), EC_LBPOOL_ID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckVars(t, EC_LBPOOL_ID_VAR)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: tpl,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", EC_LBPOOL_ID),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "members.0.address", "10.10.2.15"),
+					resource.TestCheckResourceAttrSet(resourceName, "members.0.operating_status"),
+				),
+			},
+		},
+	})
+}
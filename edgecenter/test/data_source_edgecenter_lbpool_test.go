@@ -125,6 +125,9 @@ func TestAccLBPoolDataSource(t *testing.T) {
 					testAccCheckResourceExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "name", poolTestName),
 					resource.TestCheckResourceAttr(resourceName, "id", pool.ID),
+					resource.TestCheckResourceAttr(resourceName, "member_count", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "operating_status"),
+					resource.TestCheckResourceAttr(resourceName, "loadbalancer_stats.#", "1"),
 				),
 			},
 		},
@@ -0,0 +1,173 @@
+//go:build cloud_data_source
+
+package edgecenter_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/Edge-Center/edgecentercloud-go/edgecenter/network/v1/networks"
+	"github.com/Edge-Center/edgecentercloud-go/edgecenter/subnet/v1/subnets"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+	utilV2 "github.com/Edge-Center/edgecentercloud-go/v2/util"
+)
+
+const PortDatasourceName = "port_datasource"
+
+var PortDatasourceInstanceName = fmt.Sprintf("%s-%s-datasource", PortDatasourceName, instanceTestName)
+
+func TestAccPortDataSource(t *testing.T) {
+	cfg, err := createTestConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := createTestCloudClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	imgs, _, err := client.Images.List(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientNet, err := createTestClient(cfg.Provider, edgecenter.NetworksPoint, edgecenter.VersionPointV1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientSubnet, err := createTestClient(cfg.Provider, edgecenter.SubnetPoint, edgecenter.VersionPointV1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var img edgecloudV2.Image
+	for _, i := range imgs {
+		if i.OSDistro == osDistroTest {
+			img = i
+			break
+		}
+	}
+	if img.ID == "" {
+		t.Fatalf("images with os_distro='%s' does not exist", osDistroTest)
+	}
+
+	volumeOpts := edgecloudV2.VolumeCreateRequest{
+		ImageID:  img.ID,
+		Source:   "image",
+		Name:     PortDatasourceName + volumeTestName,
+		Size:     5,
+		TypeName: "standard",
+	}
+
+	volumeID, err := createTestVolumeV2(ctx, client, &volumeOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Volumes.Delete(ctx, volumeID)
+
+	opts := networks.CreateOpts{
+		Name: PortDatasourceName + networkTestName,
+	}
+
+	networkID, err := createTestNetwork(clientNet, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer networks.Delete(clientNet, networkID)
+
+	optsSubnet := subnets.CreateOpts{
+		Name:      PortDatasourceName + subnetTestName,
+		NetworkID: networkID,
+	}
+
+	subnetID, err := createTestSubnet(clientSubnet, optsSubnet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bootIndex := 0
+
+	volumes := []edgecloudV2.InstanceVolumeCreate{
+		{
+			Source:    "existing-volume",
+			BootIndex: &bootIndex,
+			VolumeID:  volumeID,
+		},
+	}
+
+	allSGs, _, err := client.SecurityGroups.List(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sgID := allSGs[0].ID
+	sgs := []edgecloudV2.ID{{ID: sgID}}
+
+	interfaces := []edgecloudV2.InstanceInterface{{
+		Type:           "subnet",
+		NetworkID:      networkID,
+		SubnetID:       subnetID,
+		SecurityGroups: sgs,
+	},
+	}
+
+	instanceCreateOpts := edgecloudV2.InstanceCreateRequest{
+		Names:         []string{PortDatasourceInstanceName},
+		NameTemplates: []string{},
+		Flavor:        FlavorG1Standart24,
+		Password:      "password",
+		Username:      "user",
+		Volumes:       volumes,
+		Interfaces:    interfaces,
+	}
+
+	taskInstanceResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, client.Instances.Create, &instanceCreateOpts, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	instanceID := taskInstanceResult.Instances[0]
+	defer client.Instances.Delete(ctx, instanceID, nil)
+
+	instancePortInterfaces, _, err := client.Instances.InterfaceList(ctx, instanceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	portID := instancePortInterfaces[0].PortID
+	portIP := instancePortInterfaces[0].IPAssignments[0].IPAddress.String()
+
+	resourceName := "data.edgecenter_port.port_acctest"
+
+	portTemplate := fmt.Sprintf(`
+			data "edgecenter_port" "port_acctest" {
+			  %s
+			  %s
+			  ip_address = "%s"
+			  network_id = "%s"
+			}
+		`, projectInfo(), regionInfo(), portIP, networkID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: portTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", portID),
+					resource.TestCheckResourceAttr(resourceName, "instance_id", instanceID),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", strconv.Itoa(1)),
+					resource.TestCheckTypeSetElemAttr(resourceName, "security_group_ids.*", sgID),
+				),
+			},
+		},
+	})
+}
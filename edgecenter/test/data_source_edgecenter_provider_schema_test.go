@@ -0,0 +1,41 @@
+//go:build cloud_data_source
+
+package edgecenter_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccProviderSchemaDataSource(t *testing.T) {
+	t.Parallel()
+	resourceName := "data.edgecenter_provider_schema.acctest"
+	tpl := `
+		data "edgecenter_provider_schema" "acctest" {}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: tpl,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resources.*", map[string]string{
+						"name":       "edgecenter_volume",
+						"importable": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resources.*", map[string]string{
+						"name":               "edgecenter_volume",
+						"force_new_fields.0": "image_id",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "data_sources.*", map[string]string{
+						"name": "edgecenter_provider_schema",
+					}),
+				),
+			},
+		},
+	})
+}
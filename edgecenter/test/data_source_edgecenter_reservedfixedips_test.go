@@ -0,0 +1,46 @@
+//go:build cloud_data_source
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccReservedFixedIPsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "data.edgecenter_reservedfixedips.acctest"
+	tpl := fmt.Sprintf(`
+	resource "edgecenter_reservedfixedip" "acctest" {
+	  %s
+      %s
+	  type = "external"
+	}
+
+	data "edgecenter_reservedfixedips" "acctest" {
+	  %s
+      %s
+	  type              = "external"
+	  ip_address_prefix = edgecenter_reservedfixedip.acctest.fixed_ip_address
+	}
+	`, projectInfo(), regionInfo(), projectInfo(), regionInfo())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: tpl,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "reserved_fixed_ips.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "reserved_fixed_ips.0.port_id", "edgecenter_reservedfixedip.acctest", "port_id"),
+					resource.TestCheckResourceAttr(resourceName, "reserved_fixed_ips.0.is_external", "true"),
+				),
+			},
+		},
+	})
+}
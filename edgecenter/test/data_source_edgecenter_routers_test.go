@@ -0,0 +1,85 @@
+//go:build cloud_data_source
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/Edge-Center/edgecentercloud-go/edgecenter/network/v1/networks"
+	"github.com/Edge-Center/edgecentercloud-go/edgecenter/router/v1/routers"
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
+)
+
+func TestAccRoutersDataSource(t *testing.T) {
+	t.Parallel()
+	cfg, err := createTestConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientNet, err := createTestClient(cfg.Provider, edgecenter.NetworksPoint, edgecenter.VersionPointV1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientRouter, err := createTestClient(cfg.Provider, edgecenter.RouterPoint, edgecenter.VersionPointV1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := networks.CreateOpts{
+		Name:         networkTestName,
+		CreateRouter: true,
+	}
+
+	networkID, err := createTestNetwork(clientNet, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer networks.Delete(clientNet, networkID)
+
+	rs, err := routers.ListAll(clientRouter, routers.ListOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	router := rs[0]
+
+	resourceName := "data.edgecenter_routers.acctest"
+	tpl := func(nameRegex string) string {
+		return fmt.Sprintf(`
+			data "edgecenter_routers" "acctest" {
+			  %s
+              %s
+              name_regex = "%s"
+			}
+		`, projectInfo(), regionInfo(), nameRegex)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// the backslashes of the quoted name are escaped for the HCL string
+				Config: tpl("^" + strings.ReplaceAll(regexp.QuoteMeta(router.Name), `\`, `\\`) + "$"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "routers.0.name", router.Name),
+					resource.TestCheckResourceAttrSet(resourceName, "routers.0.id"),
+					resource.TestCheckResourceAttrSet(resourceName, "routers.0.status"),
+				),
+			},
+			{
+				Config: tpl("^no-router-matches-this-name$"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "routers.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -70,6 +70,15 @@ func TestAccSecretDataSource(t *testing.T) {
 	}
 	`, projectInfo(), regionInfo(), secretTestName)
 
+	kpValidTemplate := fmt.Sprintf(`
+	data "edgecenter_secret" "acctest" {
+	  %s
+      %s
+      name                   = "%s"
+      must_not_expire_within = "720h"
+	}
+	`, projectInfo(), regionInfo(), secretTestName)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
@@ -81,6 +90,13 @@ func TestAccSecretDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", secretTestName),
 				),
 			},
+			{
+				Config: kpValidTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", secretID.(string)),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,43 @@
+//go:build cloud_data_source
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSecretsDataSource(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "data.edgecenter_secrets.acctest"
+	tpl := fmt.Sprintf(`
+	data "edgecenter_secrets" "acctest" {
+	  %s
+      %s
+	}
+
+	data "edgecenter_secrets" "expiring" {
+	  %s
+      %s
+      expiring_within_days = 30
+	}
+	`, projectInfo(), regionInfo(), projectInfo(), regionInfo())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: tpl,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "secrets.#"),
+					testAccCheckResourceExists("data.edgecenter_secrets.expiring"),
+				),
+			},
+		},
+	})
+}
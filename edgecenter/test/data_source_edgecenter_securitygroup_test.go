@@ -87,6 +87,7 @@ func TestAccSecurityGroupDataSource(t *testing.T) {
 					testAccCheckResourceExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "name", sg1.Name),
 					resource.TestCheckResourceAttr(resourceName, "id", sg1.ID),
+					resource.TestCheckResourceAttrSet(resourceName, "rules_json"),
 					testAccCheckMetadata(t, resourceName, true, map[string]interface{}{
 						"key1": "val1", "key2": "val2",
 					}),
@@ -0,0 +1,43 @@
+//go:build storage
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
+)
+
+func TestStorageSFTPDataSource(t *testing.T) {
+	t.Parallel()
+	dataSourceName := "data.edgecenter_storage_sftp.acctest"
+
+	templateRead := func() string {
+		return fmt.Sprintf(`
+data "edgecenter_storage_sftp" "acctest" {
+  storage_id = %s
+}
+		`, EC_STORAGE_SFTP_ID)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckVars(t, EC_USERNAME_VAR, EC_PASSWORD_VAR, EC_STORAGE_URL_VAR, EC_STORAGE_SFTP_ID_VAR)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: templateRead(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, edgecenter.StorageSchemaID, EC_STORAGE_SFTP_ID),
+					resource.TestCheckResourceAttrSet(dataSourceName, edgecenter.StorageSFTPSchemaHost),
+					resource.TestCheckResourceAttrSet(dataSourceName, edgecenter.StorageSFTPSchemaLogin),
+				),
+			},
+		},
+	})
+}
@@ -69,6 +69,7 @@ const (
 	EC_CDN_URL_VAR            VarName = "EC_CDN_URL"
 	EC_STORAGE_URL_VAR        VarName = "EC_STORAGE_API"
 	EC_DNS_URL_VAR            VarName = "EC_DNS_API"
+	EC_DNS_NAMESERVER_VAR     VarName = "EC_DNS_NAMESERVER"
 	EC_IMAGE_VAR              VarName = "EC_IMAGE"
 	EC_SECGROUP_VAR           VarName = "EC_SECGROUP"
 	EC_EXT_NET_VAR            VarName = "EC_EXT_NET"
@@ -85,6 +86,7 @@ const (
 	EC_CLUSTER_ID_VAR         VarName = "EC_CLUSTER_ID"
 	EC_CLUSTER_POOL_ID_VAR    VarName = "EC_CLUSTER_POOL_ID"
 	EC_PERMANENT_TOKEN_VAR    VarName = "EC_PERMANENT_TOKEN"
+	EC_STORAGE_SFTP_ID_VAR    VarName = "EC_STORAGE_SFTP_ID"
 )
 
 func getEnv(name VarName) string {
@@ -110,10 +112,12 @@ var (
 	EC_CDN_RESOURCE_ID    = getEnv(EC_CDN_RESOURCE_ID_VAR)
 	EC_STORAGE_API        = getEnv(EC_STORAGE_URL_VAR)
 	EC_DNS_API            = getEnv(EC_DNS_URL_VAR)
+	EC_DNS_NAMESERVER     = getEnv(EC_DNS_NAMESERVER_VAR)
 	EC_NETWORK_ID         = getEnv(EC_NETWORK_ID_VAR)
 	EC_SUBNET_ID          = getEnv(EC_SUBNET_ID_VAR)
 	EC_CLUSTER_ID         = getEnv(EC_CLUSTER_ID_VAR)
 	EC_CLUSTER_POOL_ID    = getEnv(EC_CLUSTER_POOL_ID_VAR)
+	EC_STORAGE_SFTP_ID    = getEnv(EC_STORAGE_SFTP_ID_VAR)
 )
 
 //nolint:unused
@@ -135,10 +139,12 @@ var varsMap = map[VarName]string{
 	EC_CDN_RESOURCE_ID_VAR:    EC_CDN_RESOURCE_ID,
 	EC_STORAGE_URL_VAR:        EC_STORAGE_API,
 	EC_DNS_URL_VAR:            EC_DNS_API,
+	EC_DNS_NAMESERVER_VAR:     EC_DNS_NAMESERVER,
 	EC_NETWORK_ID_VAR:         EC_NETWORK_ID,
 	EC_SUBNET_ID_VAR:          EC_SUBNET_ID,
 	EC_CLUSTER_ID_VAR:         EC_CLUSTER_ID,
 	EC_CLUSTER_POOL_ID_VAR:    EC_CLUSTER_POOL_ID,
+	EC_STORAGE_SFTP_ID_VAR:    EC_STORAGE_SFTP_ID,
 }
 
 //nolint:unused
@@ -0,0 +1,124 @@
+//go:build cloud_resource
+
+package edgecenter_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
+)
+
+func TestAccFloatingIPAssociation(t *testing.T) {
+	t.Parallel()
+	resourceName := "edgecenter_floatingip_association.acctest"
+
+	tpl := func(port string) string {
+		return fmt.Sprintf(`
+			resource "edgecenter_network" "acctest" {
+			  %[1]s
+			  %[2]s
+			  name = "fip_association_acctest"
+			  type = "vxlan"
+			}
+
+			resource "edgecenter_subnet" "acctest" {
+			  %[1]s
+			  %[2]s
+			  name = "fip_association_acctest"
+			  cidr = "192.168.42.0/24"
+			  network_id = edgecenter_network.acctest.id
+			}
+
+			resource "edgecenter_reservedfixedip" "first" {
+			  %[1]s
+			  %[2]s
+			  type = "subnet"
+			  subnet_id = edgecenter_subnet.acctest.id
+			}
+
+			resource "edgecenter_reservedfixedip" "second" {
+			  %[1]s
+			  %[2]s
+			  type = "subnet"
+			  subnet_id = edgecenter_subnet.acctest.id
+			}
+
+			resource "edgecenter_floatingip" "acctest" {
+			  %[1]s
+			  %[2]s
+
+			  lifecycle {
+			    ignore_changes = [port_id, fixed_ip_address]
+			  }
+			}
+
+			resource "edgecenter_floatingip_association" "acctest" {
+			  %[1]s
+			  %[2]s
+			  floating_ip_id = edgecenter_floatingip.acctest.id
+			  port_id = edgecenter_reservedfixedip.%[3]s.port_id
+			}
+		`, projectInfo(), regionInfo(), port)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccFloatingIPAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: tpl("first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "port_id", "edgecenter_reservedfixedip.first", "port_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "fixed_ip_address", "edgecenter_reservedfixedip.first", "fixed_ip_address"),
+				),
+			},
+			{
+				Config: tpl("second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "edgecenter_floatingip.acctest", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "port_id", "edgecenter_reservedfixedip.second", "port_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "fixed_ip_address", "edgecenter_reservedfixedip.second", "fixed_ip_address"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName]
+					return fmt.Sprintf("%s:%s:%s:%s", os.Getenv("TEST_PROJECT_ID"), os.Getenv("TEST_REGION_ID"),
+						rs.Primary.ID, rs.Primary.Attributes["port_id"]), nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"project_name", "region_name"},
+			},
+		},
+	})
+}
+
+func testAccFloatingIPAssociationDestroy(s *terraform.State) error {
+	client, err := createTestCloudClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != edgecenter.FloatingIPAssociationResource {
+			continue
+		}
+
+		floatingIP, _, err := client.Floatingips.Get(context.Background(), rs.Primary.ID)
+		if err == nil && floatingIP.PortID != "" {
+			return fmt.Errorf("floating ip %s is still associated with port %s", rs.Primary.ID, floatingIP.PortID)
+		}
+	}
+
+	return nil
+}
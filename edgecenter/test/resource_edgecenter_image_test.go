@@ -0,0 +1,91 @@
+//go:build cloud_resource
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccImage(t *testing.T) {
+	t.Parallel()
+
+	type Params struct {
+		Name          string
+		OSType        string
+		HWMachineType string
+		SSHKey        string
+		Env           string
+	}
+
+	create := Params{"test-image", "linux", "i440", "allow", "test"}
+
+	update := Params{"test-image-updated", "linux", "q35", "required", "prod"}
+
+	resourceName := "edgecenter_image.acctest"
+
+	tpl := func(params *Params) string {
+		return fmt.Sprintf(`
+		resource "edgecenter_volume" "acctest" {
+		  name = "image volume"
+		  type_name = "standard"
+		  size = 1
+		  %[1]s
+		  %[2]s
+		}
+
+		resource "edgecenter_image" "acctest" {
+		  name = "%[3]s"
+		  volume_id = edgecenter_volume.acctest.id
+		  os_type = "%[4]s"
+		  hw_machine_type = "%[5]s"
+		  ssh_key = "%[6]s"
+		  metadata_map = {
+		    env = "%[7]s"
+		  }
+		  %[1]s
+		  %[2]s
+		}
+		`, projectInfo(), regionInfo(), params.Name, params.OSType, params.HWMachineType, params.SSHKey, params.Env)
+	}
+
+	var imageID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: tpl(&create),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", create.Name),
+					resource.TestCheckResourceAttr(resourceName, "hw_machine_type", create.HWMachineType),
+					resource.TestCheckResourceAttr(resourceName, "ssh_key", create.SSHKey),
+					resource.TestCheckResourceAttr(resourceName, "metadata_map.env", create.Env),
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(value string) error {
+						imageID = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: tpl(&update),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", update.Name),
+					resource.TestCheckResourceAttr(resourceName, "hw_machine_type", update.HWMachineType),
+					resource.TestCheckResourceAttr(resourceName, "ssh_key", update.SSHKey),
+					resource.TestCheckResourceAttr(resourceName, "metadata_map.env", update.Env),
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(value string) error {
+						if value != imageID {
+							return fmt.Errorf("image was recreated: %s != %s", value, imageID)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
@@ -308,6 +308,7 @@ configuration = [`)
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckResourceExists(resourceName),
 					checkInstanceV2Attrs(resourceName, &updateInterfacefixt),
+					resource.TestCheckResourceAttrSet(resourceName, "interfaces.0.port_id"),
 				),
 			},
 		},
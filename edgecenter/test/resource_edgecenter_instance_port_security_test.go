@@ -7,6 +7,8 @@ import (
 	"fmt"
 	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
 	utilV2 "github.com/Edge-Center/edgecentercloud-go/v2/util"
+	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -157,19 +159,19 @@ func TestAccInstancePortSecurity(t *testing.T) {
 
 	type Params struct {
 		PortSecurityDisabled bool
-		OverwriteExisting    bool
+		ManagementPolicy     string
 		SecurityGroups       []string
 	}
 
 	create := Params{
 		PortSecurityDisabled: false,
-		OverwriteExisting:    false,
+		ManagementPolicy:     edgecenter.PortSecurityPolicyIgnoreExternal,
 		SecurityGroups:       []string{},
 	}
 
 	update := Params{
 		PortSecurityDisabled: false,
-		OverwriteExisting:    true,
+		ManagementPolicy:     edgecenter.PortSecurityPolicyStrict,
 		SecurityGroups:       sgsUpdate,
 	}
 	resourceName := "edgecenter_instance_port_security.instance_port_security_acctest"
@@ -183,11 +185,11 @@ func TestAccInstancePortSecurity(t *testing.T) {
 			  instance_id = "%s"
 			  port_security_disabled = %t
 			  security_groups {
-				overwrite_existing = %t
+				management_policy = "%s"
 				security_group_ids = [%s] 
               }
 			}
-		`, projectInfo(), regionInfo(), portID, instanceID, params.PortSecurityDisabled, params.OverwriteExisting, strings.Join(params.SecurityGroups, ", "))
+		`, projectInfo(), regionInfo(), portID, instanceID, params.PortSecurityDisabled, params.ManagementPolicy, strings.Join(params.SecurityGroups, ", "))
 	}
 
 	resource.Test(t, resource.TestCase{
@@ -199,7 +201,7 @@ func TestAccInstancePortSecurity(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckResourceExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "security_groups.0.all_security_group_ids.0", sgID),
-					resource.TestCheckResourceAttr(resourceName, "security_groups.0.overwrite_existing", fmt.Sprintf("%t", create.OverwriteExisting)),
+					resource.TestCheckResourceAttr(resourceName, "security_groups.0.management_policy", create.ManagementPolicy),
 					resource.TestCheckResourceAttr(resourceName, "port_security_disabled", fmt.Sprintf("%t", create.PortSecurityDisabled)),
 					resource.TestCheckResourceAttr(resourceName, "security_groups.0.security_group_ids.#", strconv.Itoa(0)),
 					resource.TestCheckResourceAttr(resourceName, "security_groups.0.all_security_group_ids.#", strconv.Itoa(1)),
@@ -217,12 +219,55 @@ func TestAccInstancePortSecurity(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckResourceExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "security_groups.0.all_security_group_ids.0", newSG1.ID),
-					resource.TestCheckResourceAttr(resourceName, "security_groups.0.overwrite_existing", fmt.Sprintf("%t", update.OverwriteExisting)),
+					resource.TestCheckResourceAttr(resourceName, "security_groups.0.management_policy", update.ManagementPolicy),
 					resource.TestCheckResourceAttr(resourceName, "port_security_disabled", fmt.Sprintf("%t", update.PortSecurityDisabled)),
 					resource.TestCheckResourceAttr(resourceName, "security_groups.0.security_group_ids.#", strconv.Itoa(1)),
 					resource.TestCheckResourceAttr(resourceName, "security_groups.0.all_security_group_ids.#", strconv.Itoa(1)),
 				),
 			},
+			{
+				ImportStateId:     fmt.Sprintf("%s:%s:%s:%s", os.Getenv("TEST_PROJECT_ID"), os.Getenv("TEST_REGION_ID"), instanceID, portID),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the imported security groups are managed with the default policy
+				ImportStateVerifyIgnore: []string{"project_name", "region_name", "security_groups.0.management_policy"},
+			},
+		},
+	})
+}
+
+func TestAccInstancePortSecurityInvalidAttrs(t *testing.T) {
+	t.Parallel()
+
+	template := func(portSecurityDisabled bool, managementPolicy string) string {
+		return fmt.Sprintf(`
+resource "edgecenter_instance_port_security" "acctest" {
+  %s
+  %s
+  port_id                = "073947f8-8589-4104-bdff-2cedbe56239f"
+  instance_id            = "4f81e8f8-d7b8-45a4-93fd-609ad2a670f0"
+  port_security_disabled = %t
+  security_groups {
+    management_policy = "%s"
+  }
+}`, projectInfo(), regionInfo(), portSecurityDisabled, managementPolicy)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      template(true, edgecenter.PortSecurityPolicyIgnoreExternal),
+				ExpectError: regexp.MustCompile("you can't set \"security_groups\" block"),
+				PlanOnly:    true,
+			},
+			{
+				Config:      template(false, edgecenter.PortSecurityPolicyStrict),
+				ExpectError: regexp.MustCompile("requires \"security_group_ids\" or \"security_group_names\""),
+				PlanOnly:    true,
+			},
 		},
 	})
 }
@@ -100,6 +100,23 @@ func TestAccLBMember(t *testing.T) {
 		`, projectInfo(), regionInfo(), poolID, params.Address, params.Port, params.Weight)
 	}
 
+	monitorAddress, monitorPort := "10.10.3.16", "9001"
+
+	monitorTpl := func(params *Params) string {
+		return fmt.Sprintf(`
+            resource "edgecenter_lbmember" "acctest" {
+			  %s
+              %s
+			  pool_id = "%s"
+			  address = "%s"
+			  protocol_port = %s
+			  weight = %s
+			  monitor_address = "%s"
+			  monitor_port = %s
+			}
+		`, projectInfo(), regionInfo(), poolID, params.Address, params.Port, params.Weight, monitorAddress, monitorPort)
+	}
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: testAccProviders,
@@ -123,6 +140,15 @@ func TestAccLBMember(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "weight", update.Weight),
 				),
 			},
+			{
+				Config: monitorTpl(&update),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address", update.Address),
+					resource.TestCheckResourceAttr(resourceName, "monitor_address", monitorAddress),
+					resource.TestCheckResourceAttr(resourceName, "monitor_port", monitorPort),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,56 @@
+//go:build cloud_resource
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
+)
+
+func TestAccQuotaNotification(t *testing.T) {
+	t.Parallel()
+	projectName := fmt.Sprintf("terraformtestquota%d", time.Now().Nanosecond())
+	resourceName := fmt.Sprintf("%s.acctest", edgecenter.QuotaNotificationResource)
+
+	template := func(threshold int) string {
+		return fmt.Sprintf(`
+resource "%s" "acctest" {
+  name = "%s"
+}
+
+resource "%s" "acctest" {
+  client_id = %s.acctest.client_id
+  threshold = %d
+}
+		`, edgecenter.ProjectResource, projectName, edgecenter.QuotaNotificationResource, edgecenter.ProjectResource, threshold)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckVars(t, EC_USERNAME_VAR, EC_PASSWORD_VAR)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: template(80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, edgecenter.QuotaNotificationThresholdField, strconv.Itoa(80)),
+				),
+			},
+			{
+				Config: template(90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, edgecenter.QuotaNotificationThresholdField, strconv.Itoa(90)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,85 @@
+//go:build cloud_resource
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
+)
+
+func TestAccSnapshotPolicyAssignment(t *testing.T) {
+	t.Parallel()
+	resName := "acctest"
+	fullName := edgecenter.SnapshotPolicyAssignmentResource + "." + resName
+
+	volumeConfig := func(name string) string {
+		return fmt.Sprintf(`
+resource "edgecenter_volume" "%s" {
+	%s
+	%s
+	name = "%s"
+	type_name = "standard"
+	size = 1
+}`, name, projectInfo(), regionInfo(), name)
+	}
+	policyConfig := fmt.Sprintf(`
+resource "%s" "%s" {
+	%s
+	%s
+	name = "policy-assignment"
+	schedule {
+		max_quantity = 1
+		cron {
+			timezone = "Europe/London"
+			hour = "2"
+		}
+	}
+	lifecycle {
+		ignore_changes = [volume]
+	}
+}`, edgecenter.LifecyclePolicyResource, resName, projectInfo(), regionInfo())
+	assignmentConfig := func(volumes string) string {
+		return fmt.Sprintf(`
+resource "%s" "%s" {
+	%s
+	%s
+	lifecycle_policy_id = %s.%s.id
+	volume_ids = [%s]
+}`, edgecenter.SnapshotPolicyAssignmentResource, resName, projectInfo(), regionInfo(), edgecenter.LifecyclePolicyResource, resName, volumes)
+	}
+	baseConfig := volumeConfig("volume1") + volumeConfig("volume2") + policyConfig
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: baseConfig + assignmentConfig("edgecenter_volume.volume1.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(fullName),
+					resource.TestCheckResourceAttr(fullName, "volume_ids.#", "1"),
+				),
+			},
+			{
+				Config: baseConfig + assignmentConfig("edgecenter_volume.volume1.id, edgecenter_volume.volume2.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(fullName),
+					resource.TestCheckResourceAttr(fullName, "volume_ids.#", "2"),
+				),
+			},
+			{
+				Config: baseConfig + assignmentConfig("edgecenter_volume.volume2.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(fullName),
+					resource.TestCheckResourceAttr(fullName, "volume_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(fullName, "volume_ids.*", "edgecenter_volume.volume2", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,57 @@
+//go:build storage
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
+)
+
+func TestAccStorageS3BucketPolicy(t *testing.T) {
+	t.Parallel()
+	random := time.Now().Nanosecond()
+	storageResourceName := fmt.Sprintf("edgecenter_storage_s3.terraform_test_%d_s3", random)
+	bucketResourceName := fmt.Sprintf("edgecenter_storage_s3_bucket.terraform_test_%d_s3_bucket", random)
+	policyResourceName := fmt.Sprintf("edgecenter_storage_s3_bucket_policy.terraform_test_%d_s3_bucket_policy", random)
+	name := fmt.Sprintf("terraform_test_%d", random)
+
+	templateCreateBucketPolicy := func() string {
+		return fmt.Sprintf(`
+resource "edgecenter_storage_s3" "terraform_test_%d_s3" {
+  name = "terraform_test_%d"
+  location = "s-ed1"
+}
+
+resource "edgecenter_storage_s3_bucket" "terraform_test_%d_s3_bucket" {
+  name = "terraform_test_%d"
+  storage_id = %s.id
+}
+
+resource "edgecenter_storage_s3_bucket_policy" "terraform_test_%d_s3_bucket_policy" {
+  storage_id = %s.storage_id
+  bucket_name = %s.name
+}
+		`, random, random, random, random, storageResourceName, random, bucketResourceName, bucketResourceName)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckVars(t, EC_USERNAME_VAR, EC_PASSWORD_VAR, EC_STORAGE_URL_VAR)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: templateCreateBucketPolicy(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(policyResourceName),
+					resource.TestCheckResourceAttr(policyResourceName, edgecenter.StorageS3BucketPolicySchemaBucketName, name),
+				),
+			},
+		},
+	})
+}
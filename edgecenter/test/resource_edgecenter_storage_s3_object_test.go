@@ -0,0 +1,75 @@
+//go:build storage
+
+package edgecenter_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
+)
+
+func TestAccStorageS3Object(t *testing.T) {
+	t.Parallel()
+	random := time.Now().Nanosecond()
+	storageResourceName := fmt.Sprintf("edgecenter_storage_s3.terraform_test_%d_s3", random)
+	bucketResourceName := fmt.Sprintf("edgecenter_storage_s3_bucket.terraform_test_%d_s3_bucket", random)
+	objectResourceName := fmt.Sprintf("edgecenter_storage_s3_object.terraform_test_%d_s3_object", random)
+
+	templateObject := func(content, cacheControl string) string {
+		return fmt.Sprintf(`
+resource "edgecenter_storage_s3" "terraform_test_%d_s3" {
+  name = "terraform_test_%d"
+  location = "s-ed1"
+}
+
+resource "edgecenter_storage_s3_bucket" "terraform_test_%d_s3_bucket" {
+  name = "terraform_test_%d"
+  storage_id = %s.id
+}
+
+resource "edgecenter_storage_s3_object" "terraform_test_%d_s3_object" {
+  storage_id = %s.storage_id
+  bucket_name = %s.name
+  access_key = %s.generated_access_key
+  secret_key = %s.generated_secret_key
+  key = "errors/404.html"
+  content = "%s"
+  content_type = "text/html"
+  cache_control = "%s"
+}
+		`, random, random, random, random, storageResourceName, random, bucketResourceName, bucketResourceName,
+			storageResourceName, storageResourceName, content, cacheControl)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckVars(t, EC_USERNAME_VAR, EC_PASSWORD_VAR, EC_STORAGE_URL_VAR)
+		},
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: templateObject("not found", "max-age=60"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceExists(objectResourceName),
+					resource.TestCheckResourceAttr(objectResourceName, edgecenter.StorageS3ObjectSchemaKey, "errors/404.html"),
+					resource.TestCheckResourceAttr(objectResourceName, edgecenter.StorageS3ObjectSchemaContentType, "text/html"),
+					resource.TestCheckResourceAttr(objectResourceName, edgecenter.StorageS3ObjectSchemaCacheControl, "max-age=60"),
+					// md5 of "not found"
+					resource.TestCheckResourceAttr(objectResourceName, edgecenter.StorageS3ObjectSchemaETag, "d8f4a1993546cc4b850cde3599e27aec"),
+				),
+			},
+			{
+				Config: templateObject("page not found", "max-age=3600"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(objectResourceName, edgecenter.StorageS3ObjectSchemaCacheControl, "max-age=3600"),
+					// md5 of "page not found"
+					resource.TestCheckResourceAttr(objectResourceName, edgecenter.StorageS3ObjectSchemaETag, "e65046f081f5859841852560e8672452"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,206 @@
+package edgecenter_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"software.sslmate.com/src/go-pkcs12"
+
+	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
+)
+
+// testPKCS12Bundle is exported by OpenSSL 3 with the default PBES2 (AES-256-CBC) encryption and the passphrase "secret":
+// openssl pkcs12 -export -inkey leaf.key -in leaf.crt -certfile ca.crt -passout pass:secret.
+const testPKCS12Bundle = "" +
+	"MIIFXAIBAzCCBRIGCSqGSIb3DQEHAaCCBQMEggT/MIIE+zCCA7IGCSqGSIb3DQEHBqCCA6MwggOfAgEAMIIDmAYJKoZIhvcNAQcB" +
+	"MFcGCSqGSIb3DQEFDTBKMCkGCSqGSIb3DQEFDDAcBAjCOmV1/MeW9gICCAAwDAYIKoZIhvcNAgkFADAdBglghkgBZQMEASoEECjl" +
+	"s3rUPhcCA+OBHO0DQX2AggMwoKvjEQ0TqC8oiDx/mAjITn1I2qycwrO4Lc9Ul38GOQAKwMgVLrEiYa3vY9jwiZKfhmv8XO+r1R80" +
+	"pyJuYsdj0v7YfT6uOdi0HeJBN+5rHUuwK5uPm/bQfKKy0diSmB40+VQ2jsaKrHJyYNna9AnBoYRROIrCfGjnT+keEVAeCpL8DQqz" +
+	"sA4Y9XSnitboDRr6RCWUzO5pe+fhPjs78+ykpZdEC1YQ5ICzbZSen10qaaUET9schmL11/X7iS1/k+SGuGb4vv4YXImgjJekwTFn" +
+	"OMe8APfzRxKhUv5ZW15UUdUuiAtHweVS5axHQNUBfd3gf7jiM5Q9epqfunzpVuAflFFzMzE9aZzf8RTL2VG+d6ugmE5TvHQ9Jirp" +
+	"Uq7NfFexV/jZuWIBejOXN1HC+mL75fyUxbRT2CRKHgTfZTbOtNFcKx2+WhKspShYLtuXYJ5qx8BRiZ22hcciokLAni0b0G6osJGI" +
+	"m1Wcp/P2Vojp236w515LsLb8QlCipG19laFxewBLzwePOjiY/eybpvGvSdv9/sq3WtW30kvwUbTtWQ+Km67/GETIYQjwvAzE/6P7" +
+	"DlXoobXdZYDiQOTN3/iaRp5AgyMwUR72MZrZEmitsbaaI2hK+WddpvTcXn2nHzH0UEASQec/mIFT/y2/68ElWOopvIYndAiNXspM" +
+	"16+cgSCnSKrn0jsUaomeCvoU9bE/3nh3vwT5/O2MUOTgBOU9RZfA2UPhgXHutH66H9d8h7TxPYjVGdLB3Tjn/WFmnas65oYWdtb6" +
+	"6uIuz+ZyNlhK6hK18KYSQ5SA6iTBb9TyD4KpbZjMwaUXjYpa5CoTQyD0qDiZZobdqveKYTSRIjuKcYmOTzqT98qc478RKhEFo85w" +
+	"CiKsZJEH40Vs3NNjd3AL/bAqRpT14T8Z0X6gv0XrYy+r84O7fqfaCH069/P71hEbH3tcr7qTSBDxk7bAZvrnB1sc6/nhn9JXVm2e" +
+	"9nCzh4NDy2fNgeYZAAb6GLaTMirwUCejKvJmtXFRv4UfwJ0cCPvkpdwtEbukMSHtK1XGZC26Nb7y8sReSfQneETFsybwK0N8CgB+" +
+	"RaJ2X/gz2OZtMIIBQQYJKoZIhvcNAQcBoIIBMgSCAS4wggEqMIIBJgYLKoZIhvcNAQwKAQKgge8wgewwVwYJKoZIhvcNAQUNMEow" +
+	"KQYJKoZIhvcNAQUMMBwECAL0XKRhTs8vAgIIADAMBggqhkiG9w0CCQUAMB0GCWCGSAFlAwQBKgQQPGeCXUcreou/hW1Ev5K8IwSB" +
+	"kGWdKyg5IFqMLnPN2nVBDoAFTW3KnhmIV7ltpag4QlOWOuUtKP6ZIbPr6VYd8/2DnsCcWePicmo0f2p7nibjKriD9XytGqXgcoUj" +
+	"Yzr9ycwJHEYxK95MKRoHRQ/qu7Y+WOykLdVQBztnKHhZ5jmFBUjyvpcfyFsQzdlSerUPiMTuhhuJ9rEEf3V6gFOwfl4pYjElMCMG" +
+	"CSqGSIb3DQEJFTEWBBT2JHfNPgPm8kTWflcEu7RBbHrj/TBBMDEwDQYJYIZIAWUDBAIBBQAEIBTOYW71vhlA2+1QpxMG55i/ictL" +
+	"n2F6lHaXuc3UegcXBAilEMJif72RmwICCAA="
+
+const testPKCS12Certificate = `-----BEGIN CERTIFICATE-----
+MIIBJTCBywIUO1qyYX90hXYYyoTRsCX16TEYjQ8wCgYIKoZIzj0EAwIwEjEQMA4G
+A1UEAwwHdGVzdC1jYTAgFw0yNjEwMTYwMzU3MjlaGA8yMTI2MDkyMjAzNTcyOVow
+FjEUMBIGA1UEAwwLZXhhbXBsZS5jb20wWTATBgcqhkjOPQIBBggqhkjOPQMBBwNC
+AAT51NY7ryiEqpfcbPbiP9y1rfWQ+CNugMQy64UvAPR1Rfvgw2pxaW71xft+L/3h
+rHNlBtPskfS8IaS0EIeqSaSfMAoGCCqGSM49BAMCA0kAMEYCIQDJZn3DzOyKM2vm
+U3K1uMTTlhgoWmvGEqnuHKkes/oVzAIhAPQHKQ30vTLpwzFw813bhMnRGikmh5sp
+RWUYJeUGBoig
+-----END CERTIFICATE-----
+`
+
+const testPKCS12Chain = `-----BEGIN CERTIFICATE-----
+MIIBezCCASGgAwIBAgIUBX+F1SKJB+MbBRaP/nedNJcG4S8wCgYIKoZIzj0EAwIw
+EjEQMA4GA1UEAwwHdGVzdC1jYTAgFw0yNjEwMTYwMzU3MjlaGA8yMTI2MDkyMjAz
+NTcyOVowEjEQMA4GA1UEAwwHdGVzdC1jYTBZMBMGByqGSM49AgEGCCqGSM49AwEH
+A0IABBvgTucjk7v9c49S1ufIDukyugq5LB4zT+Ve0a3bmuWwKMbRrP6DcXdwfkTX
+Aed7Yl+nBUM4mLLd7Fse2X99MvijUzBRMB0GA1UdDgQWBBRec+hkOvyWZbEfMeE3
+Ec3eRx+MSTAfBgNVHSMEGDAWgBRec+hkOvyWZbEfMeE3Ec3eRx+MSTAPBgNVHRMB
+Af8EBTADAQH/MAoGCCqGSM49BAMCA0gAMEUCIF3MR6Kid3VGQSDpzxuiu4r1Qwt7
+fKZsY0uMqAeSwCx3AiEAsyPzS/Z1LFCXGz+RO5O05oKg4kyLewXSuFHYIxatpYs=
+-----END CERTIFICATE-----
+`
+
+func TestSplitPKCS12(t *testing.T) {
+	t.Parallel()
+
+	certificate, chain, privateKey, err := edgecenter.SplitPKCS12(testPKCS12Bundle, "secret")
+	if err != nil {
+		t.Fatalf("SplitPKCS12() error = %v", err)
+	}
+	if certificate != testPKCS12Certificate {
+		t.Errorf("SplitPKCS12() certificate = %v, want %v", certificate, testPKCS12Certificate)
+	}
+	if chain != testPKCS12Chain {
+		t.Errorf("SplitPKCS12() chain = %v, want %v", chain, testPKCS12Chain)
+	}
+	if _, err := tls.X509KeyPair([]byte(certificate), []byte(privateKey)); err != nil {
+		t.Errorf("SplitPKCS12() private key does not match the certificate: %v", err)
+	}
+}
+
+func TestSplitPKCS12Encodings(t *testing.T) {
+	t.Parallel()
+
+	caKey, caCert := createTestCertificate(t, "test-ca", nil, nil)
+	leafKey, leafCert := createTestCertificate(t, "example.com", caKey, caCert)
+	leafPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafCert.Raw}))
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCert.Raw}))
+
+	tests := []struct {
+		name    string
+		encoder *pkcs12.Encoder
+		first   *x509.Certificate
+		rest    []*x509.Certificate
+	}{
+		{
+			name:    "modern encryption",
+			encoder: pkcs12.Modern2023,
+			first:   leafCert,
+			rest:    []*x509.Certificate{caCert},
+		},
+		{
+			name:    "legacy encryption",
+			encoder: pkcs12.LegacyRC2,
+			first:   leafCert,
+			rest:    []*x509.Certificate{caCert},
+		},
+		{
+			name:    "leaf certificate is not the first one",
+			encoder: pkcs12.Modern2023,
+			first:   caCert,
+			rest:    []*x509.Certificate{leafCert},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := tt.encoder.Encode(leafKey, tt.first, tt.rest, "secret")
+			if err != nil {
+				t.Fatalf("cannot encode pkcs12 bundle: %v", err)
+			}
+			certificate, chain, _, err := edgecenter.SplitPKCS12(base64.StdEncoding.EncodeToString(data), "secret")
+			if err != nil {
+				t.Fatalf("SplitPKCS12() error = %v", err)
+			}
+			if certificate != leafPEM {
+				t.Errorf("SplitPKCS12() certificate = %v, want %v", certificate, leafPEM)
+			}
+			if chain != caPEM {
+				t.Errorf("SplitPKCS12() chain = %v, want %v", chain, caPEM)
+			}
+		})
+	}
+}
+
+func TestSplitPKCS12Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		bundle     string
+		passphrase string
+	}{
+		{
+			name:       "wrong passphrase",
+			bundle:     testPKCS12Bundle,
+			passphrase: "wrong",
+		},
+		{
+			name:       "not base64",
+			bundle:     "not a bundle",
+			passphrase: "secret",
+		},
+		{
+			name:       "not pkcs12",
+			bundle:     base64.StdEncoding.EncodeToString([]byte("not a bundle")),
+			passphrase: "secret",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, _, _, err := edgecenter.SplitPKCS12(tt.bundle, tt.passphrase); err == nil {
+				t.Errorf("SplitPKCS12() error = nil, want error")
+			}
+		})
+	}
+}
+
+// createTestCertificate creates an EC key and a certificate for it, which is self-signed if the parent is nil.
+func createTestCertificate(t *testing.T, commonName string, parentKey *ecdsa.PrivateKey, parent *x509.Certificate) (*ecdsa.PrivateKey, *x509.Certificate) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  parent == nil,
+		BasicConstraintsValid: true,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key, cert
+}
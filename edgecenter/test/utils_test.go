@@ -1,8 +1,11 @@
 package edgecenter_test
 
 import (
+	"errors"
+	"net/http"
 	"testing"
 
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
 	"github.com/Edge-Center/terraform-provider-edgecenter/edgecenter"
 )
 
@@ -65,3 +68,71 @@ func TestExtractHostAndPath(t *testing.T) {
 		})
 	}
 }
+
+func TestClassifyAPIError(t *testing.T) {
+	t.Parallel()
+
+	apiErr := errors.New("api error")
+	tests := []struct {
+		name    string
+		resp    *edgecloudV2.Response
+		err     error
+		wantErr error
+	}{
+		{
+			name:    "not found",
+			resp:    &edgecloudV2.Response{Response: &http.Response{StatusCode: http.StatusNotFound}},
+			err:     apiErr,
+			wantErr: edgecenter.ErrNotFound,
+		},
+		{
+			name:    "conflict",
+			resp:    &edgecloudV2.Response{Response: &http.Response{StatusCode: http.StatusConflict}},
+			err:     apiErr,
+			wantErr: edgecenter.ErrConflict,
+		},
+		{
+			name:    "service unavailable",
+			resp:    &edgecloudV2.Response{Response: &http.Response{StatusCode: http.StatusServiceUnavailable}},
+			err:     apiErr,
+			wantErr: edgecenter.ErrTransient,
+		},
+		{
+			name:    "quota exceeded",
+			resp:    &edgecloudV2.Response{Response: &http.Response{StatusCode: http.StatusBadRequest}},
+			err:     errors.New("Quota exceeded for resources: ports"),
+			wantErr: edgecenter.ErrQuotaExceeded,
+		},
+		{
+			name:    "task failed",
+			resp:    nil,
+			err:     errors.New("task with error state; task_type: attach_security_group; err: port is busy"),
+			wantErr: edgecenter.ErrTaskFailed,
+		},
+		{
+			name:    "no response",
+			resp:    nil,
+			err:     apiErr,
+			wantErr: apiErr,
+		},
+		{
+			name:    "no error",
+			resp:    &edgecloudV2.Response{Response: &http.Response{StatusCode: http.StatusOK}},
+			err:     nil,
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := edgecenter.ClassifyAPIError(tt.resp, tt.err)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ClassifyAPIError() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.err != nil && !errors.Is(err, tt.err) {
+				t.Errorf("ClassifyAPIError() error = %v, original error is lost", err)
+			}
+		})
+	}
+}
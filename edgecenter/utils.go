@@ -108,11 +108,9 @@ func CreateClient(provider *edgecloud.ProviderClient, d *schema.ResourceData, en
 
 	regionID := 0
 
-	rawRegionID := d.Get("region_id")
-	rawRegionName := d.Get("region_name")
-
-	if rawRegionID != nil && rawRegionName != nil {
-		regionID, err = GetRegionLegacy(provider, rawRegionID.(int), rawRegionName.(string))
+	if d.Get("region_id") != nil && d.Get("region_name") != nil {
+		rawRegionID, rawRegionName := configuredRegion(d)
+		regionID, err = GetRegionLegacy(provider, rawRegionID, rawRegionName)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get region: %w", err)
 		}
@@ -195,14 +193,13 @@ func GetRegionID(
 	client *edgecloudV2.Client,
 	d *schema.ResourceData,
 ) (int, error) {
-	rID, IDOk := d.GetOk("region_id")
-	rName, NameOk := d.GetOk("region_name")
+	rID, rName := configuredRegion(d)
 
-	if !IDOk && !NameOk {
+	if rID == 0 && rName == "" {
 		return 0, fmt.Errorf("both parameters and region_id and region_name are not provided")
 	}
 
-	regionID, err := GetRegionV2(ctx, client, rID.(int), rName.(string))
+	regionID, err := GetRegionV2(ctx, client, rID, rName)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get region: %w", err)
 	}
@@ -269,3 +266,43 @@ func Reduce[T any, U any](arr []T, reduceFunc func(U, T) U, acc U) U {
 	}
 	return acc
 }
+
+// withNamePrefix returns the name to be sent to the API, with the name_prefix of the provider added.
+func withNamePrefix(m interface{}, name string) string {
+	prefix := m.(*Config).NamePrefix
+	if prefix == "" || name == "" {
+		return name
+	}
+
+	return prefix + name
+}
+
+// prefixedNames returns the names to look an object up by its name in the configuration: first with the name_prefix
+// of the provider, so the objects created by the provider are found, then as is, so the objects created elsewhere are found too.
+func prefixedNames(m interface{}, name string) []string {
+	if prefixed := withNamePrefix(m, name); prefixed != name {
+		return []string{prefixed, name}
+	}
+
+	return []string{name}
+}
+
+// findByPrefixedName returns the item found by the name in the configuration, see prefixedNames.
+func findByPrefixedName[T any](m interface{}, name string, items []T, nameOf func(T) string) (T, bool) {
+	for _, candidate := range prefixedNames(m, name) {
+		for _, item := range items {
+			if nameOf(item) == candidate {
+				return item, true
+			}
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// withoutNamePrefix returns the name read from the API without the name_prefix of the provider,
+// so it matches the name in the configuration.
+func withoutNamePrefix(m interface{}, name string) string {
+	return strings.TrimPrefix(name, m.(*Config).NamePrefix)
+}
@@ -0,0 +1,179 @@
+package edgecenter
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"strings"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	dnsQueryTimeout = 5 * time.Second
+	dnsTypeCAA      = dnsmessage.Type(257)
+)
+
+// dnsQueryTypes are the record types supported by the DNS zone records.
+var dnsQueryTypes = map[string]dnsmessage.Type{
+	"A":     dnsmessage.TypeA,
+	"AAAA":  dnsmessage.TypeAAAA,
+	"MX":    dnsmessage.TypeMX,
+	"CNAME": dnsmessage.TypeCNAME,
+	"TXT":   dnsmessage.TypeTXT,
+	"CAA":   dnsTypeCAA,
+	"NS":    dnsmessage.TypeNS,
+	"SRV":   dnsmessage.TypeSRV,
+}
+
+// dnsAnswer is the result of a DNS query: the values of the records and the lowest TTL of them.
+type dnsAnswer struct {
+	Values []string
+	TTL    int
+}
+
+// zoneNameservers looks up the authoritative nameservers of the zone with the system resolver.
+func zoneNameservers(ctx context.Context, zone string) ([]string, error) {
+	nss, err := net.DefaultResolver.LookupNS(ctx, zone)
+	if err != nil {
+		return nil, fmt.Errorf("cannot look up nameservers of zone %s: %w", zone, err)
+	}
+	nameservers := make([]string, 0, len(nss))
+	for _, ns := range nss {
+		nameservers = append(nameservers, net.JoinHostPort(strings.TrimSuffix(ns.Host, "."), "53"))
+	}
+
+	return nameservers, nil
+}
+
+// queryDNS sends a non-recursive query for the records of the domain to the nameserver.
+// The query is sent over UDP and repeated over TCP if the answer is truncated.
+func queryDNS(ctx context.Context, nameserver, domain string, qtype dnsmessage.Type) (*dnsAnswer, error) {
+	name, err := dnsmessage.NewName(strings.TrimSuffix(domain, ".") + ".")
+	if err != nil {
+		return nil, fmt.Errorf("wrong domain %s: %w", domain, err)
+	}
+	query := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: uint16(rand.Uint32())}, //nolint: gosec
+		Questions: []dnsmessage.Question{{Name: name, Type: qtype, Class: dnsmessage.ClassINET}},
+	}
+
+	resp, err := exchangeDNS(ctx, "udp", nameserver, query)
+	if err == nil && resp.Truncated {
+		resp, err = exchangeDNS(ctx, "tcp", nameserver, query)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot query nameserver %s: %w", nameserver, err)
+	}
+
+	switch resp.RCode {
+	case dnsmessage.RCodeSuccess:
+	case dnsmessage.RCodeNameError:
+		return &dnsAnswer{Values: []string{}}, nil
+	default:
+		return nil, fmt.Errorf("nameserver %s answered %s", nameserver, resp.RCode)
+	}
+
+	answer := &dnsAnswer{Values: make([]string, 0, len(resp.Answers))}
+	for _, rr := range resp.Answers {
+		if rr.Header.Type != qtype {
+			continue
+		}
+		value, err := dnsResourceValue(rr.Body)
+		if err != nil {
+			return nil, err
+		}
+		answer.Values = append(answer.Values, value)
+		if ttl := int(rr.Header.TTL); len(answer.Values) == 1 || ttl < answer.TTL {
+			answer.TTL = ttl
+		}
+	}
+
+	return answer, nil
+}
+
+func exchangeDNS(ctx context.Context, network, nameserver string, query dnsmessage.Message) (*dnsmessage.Message, error) {
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, nameserver)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(dnsQueryTimeout)); err != nil {
+		return nil, err
+	}
+
+	var raw []byte
+	if network == "tcp" {
+		// Messages over TCP are prefixed with their length.
+		if _, err := conn.Write(append(binary.BigEndian.AppendUint16(nil, uint16(len(packed))), packed...)); err != nil {
+			return nil, err
+		}
+		var length [2]byte
+		if _, err := io.ReadFull(conn, length[:]); err != nil {
+			return nil, err
+		}
+		raw = make([]byte, binary.BigEndian.Uint16(length[:]))
+		if _, err := io.ReadFull(conn, raw); err != nil {
+			return nil, err
+		}
+	} else {
+		if _, err := conn.Write(packed); err != nil {
+			return nil, err
+		}
+		raw = make([]byte, 65535)
+		n, err := conn.Read(raw)
+		if err != nil {
+			return nil, err
+		}
+		raw = raw[:n]
+	}
+
+	var resp dnsmessage.Message
+	if err := resp.Unpack(raw); err != nil {
+		return nil, err
+	}
+	if resp.ID != query.ID {
+		return nil, errors.New("answer doesn't match the query")
+	}
+
+	return &resp, nil
+}
+
+// dnsResourceValue formats the record the same way as the content of the DNS zone record resource.
+func dnsResourceValue(body dnsmessage.ResourceBody) (string, error) {
+	switch b := body.(type) {
+	case *dnsmessage.AResource:
+		return net.IP(b.A[:]).String(), nil
+	case *dnsmessage.AAAAResource:
+		return net.IP(b.AAAA[:]).String(), nil
+	case *dnsmessage.CNAMEResource:
+		return b.CNAME.String(), nil
+	case *dnsmessage.NSResource:
+		return b.NS.String(), nil
+	case *dnsmessage.MXResource:
+		return fmt.Sprintf("%d %s", b.Pref, b.MX.String()), nil
+	case *dnsmessage.TXTResource:
+		return strings.Join(b.TXT, ""), nil
+	case *dnsmessage.SRVResource:
+		return fmt.Sprintf("%d %d %d %s", b.Priority, b.Weight, b.Port, b.Target.String()), nil
+	case *dnsmessage.UnknownResource:
+		if b.Type == dnsTypeCAA && len(b.Data) >= 2 && len(b.Data) >= 2+int(b.Data[1]) {
+			tagEnd := 2 + int(b.Data[1])
+			return fmt.Sprintf("%d %s \"%s\"", b.Data[0], b.Data[2:tagEnd], b.Data[tagEnd:]), nil
+		}
+		return "", fmt.Errorf("unsupported DNS record type %s", b.Type)
+	default:
+		return "", fmt.Errorf("unsupported DNS record %T", body)
+	}
+}
@@ -0,0 +1,89 @@
+package edgecenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/connerdouglass/go-retry"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+// Typed errors returned by helpers talking to the cloud API.
+// Use errors.Is to check them.
+var (
+	ErrNotFound      = errors.New("resource not found")
+	ErrConflict      = errors.New("resource is in a conflicting state")
+	ErrQuotaExceeded = errors.New("quota exceeded")
+	ErrTaskFailed    = errors.New("task failed")
+	ErrTransient     = errors.New("transient API failure")
+)
+
+// taskErrorStateMessage starts the errors of the task waiters of the client for the tasks which end in the error state.
+const taskErrorStateMessage = "task with error state"
+
+// ClassifyAPIError wraps err with one of the typed errors according to the response of the API.
+// The errors of the task waiters, which have no response, are wrapped with ErrTaskFailed when the task failed.
+// The original error is kept in the chain, so its message is not lost.
+func ClassifyAPIError(resp *edgecloudV2.Response, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch {
+	case strings.Contains(strings.ToLower(err.Error()), "quota"):
+		return fmt.Errorf("%w: %w", ErrQuotaExceeded, err)
+	case strings.HasPrefix(err.Error(), taskErrorStateMessage):
+		return fmt.Errorf("%w: %w", ErrTaskFailed, err)
+	case resp == nil || resp.Response == nil:
+		return err
+	case resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	case resp.StatusCode == http.StatusConflict:
+		return fmt.Errorf("%w: %w", ErrConflict, err)
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode == http.StatusBadGateway,
+		resp.StatusCode == http.StatusServiceUnavailable, resp.StatusCode == http.StatusGatewayTimeout:
+		return fmt.Errorf("%w: %w", ErrTransient, err)
+	}
+
+	return err
+}
+
+// retryOnConflict runs fn and retries it with exponential backoff while it fails with ErrConflict.
+func retryOnConflict(ctx context.Context, fn func(ctx context.Context) error) error {
+	return retry.Run(
+		ctx,
+		retry.Limit(4),
+		retry.Exponential(time.Second),
+		func(ctx context.Context) error {
+			if err := fn(ctx); err != nil {
+				if errors.Is(err, ErrConflict) {
+					return retry.RetryErr(err)
+				}
+				return err
+			}
+			return nil
+		})
+}
+
+// retryOnTransientError runs fn and retries it with exponential backoff while it fails with ErrConflict,
+// ErrTransient or ErrTaskFailed.
+func retryOnTransientError(ctx context.Context, fn func(ctx context.Context) error) error {
+	return retry.Run(
+		ctx,
+		retry.Limit(4),
+		retry.Exponential(time.Second),
+		func(ctx context.Context) error {
+			if err := fn(ctx); err != nil {
+				if errors.Is(err, ErrConflict) || errors.Is(err, ErrTransient) || errors.Is(err, ErrTaskFailed) {
+					return retry.RetryErr(err)
+				}
+				return err
+			}
+			return nil
+		})
+}
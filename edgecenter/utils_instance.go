@@ -8,12 +8,14 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net"
+	"net/http"
 	"reflect"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mitchellh/mapstructure"
 	"golang.org/x/sync/errgroup"
@@ -175,16 +177,152 @@ func extractInstanceV2InterfaceOptsToListCreate(interfaces []interface{}) []edge
 }
 
 // prepareInstanceInterfaceCreateOpts prepares interface options for instance create request.
-func prepareInstanceInterfaceCreateOpts(ctx context.Context, client *edgecloudV2.Client, interfaces []interface{}) ([]edgecloudV2.InstanceInterface, error) {
+// The fixed IP addresses of the interfaces are reserved as ports, which are returned to be deleted if the instance is not created.
+func prepareInstanceInterfaceCreateOpts(ctx context.Context, client *edgecloudV2.Client, interfaces []interface{}) ([]edgecloudV2.InstanceInterface, []string, error) {
 	ifsOpts := extractInstanceV2InterfaceOptsToListCreate(interfaces)
 	defaultSG, err := utilV2.FindDefaultSG(ctx, client)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	var reservedPortIDs []string
 	for idx := range ifsOpts {
 		ifsOpts[idx].SecurityGroups = []edgecloudV2.ID{{ID: defaultSG.ID}}
+
+		iFaceMap := interfaces[idx].(map[string]interface{})
+		if fixedIPAddress, _ := iFaceMap[InstanceFixedIPAddressField].(string); fixedIPAddress != "" {
+			portID, err := reserveInterfaceFixedIPAddress(ctx, client, ifsOpts[idx].SubnetID, fixedIPAddress)
+			if err != nil {
+				return nil, reservedPortIDs, err
+			}
+			reservedPortIDs = append(reservedPortIDs, portID)
+			ifsOpts[idx] = edgecloudV2.InstanceInterface{
+				Type:           edgecloudV2.InterfaceTypeReservedFixedIP,
+				PortID:         portID,
+				SecurityGroups: ifsOpts[idx].SecurityGroups,
+			}
+		}
 	}
-	return ifsOpts, nil
+	return ifsOpts, reservedPortIDs, nil
+}
+
+// reserveInterfaceFixedIPAddress reserves the fixed IP address in the network of the subnet and returns the ID of the port.
+// The API does not accept an IP address for the interfaces of type 'subnet', so the interface is attached with this port.
+func reserveInterfaceFixedIPAddress(ctx context.Context, client *edgecloudV2.Client, subnetID, ipAddress string) (string, error) {
+	subnet, _, err := client.Subnetworks.Get(ctx, subnetID)
+	if err != nil {
+		return "", fmt.Errorf("cannot get subnet %s: %w", subnetID, err)
+	}
+
+	opts := &edgecloudV2.ReservedFixedIPCreateRequest{
+		Type:      edgecloudV2.ReservedFixedIPTypeIPAddress,
+		NetworkID: subnet.NetworkID,
+		IPAddress: ipAddress,
+	}
+	log.Printf("[DEBUG] reserve fixed IP address opts: %+v", opts)
+	taskResult, err := utilV2.ExecuteAndExtractTaskResult(ctx, client.ReservedFixedIP.Create, opts, client, ReservedFixedIPCreateTimeout)
+	if err != nil {
+		return "", fmt.Errorf("cannot reserve fixed IP address %s: %w", ipAddress, err)
+	}
+
+	return taskResult.Ports[0], nil
+}
+
+// releaseInterfaceFixedIPAddresses deletes the ports reserved for the fixed IP addresses of the interfaces.
+func releaseInterfaceFixedIPAddresses(ctx context.Context, client *edgecloudV2.Client, portIDs []string) error {
+	for _, portID := range portIDs {
+		log.Printf("[DEBUG] release fixed IP address port %s", portID)
+		results, resp, err := client.ReservedFixedIP.Delete(ctx, portID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return fmt.Errorf("cannot delete reserved fixed IP port %s: %w", portID, err)
+		}
+		if err := utilV2.WaitForTaskComplete(ctx, client, results.Tasks[0], ReservedFixedIPDeleteTimeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// interfaceFixedIPAddressPortIDs returns the IDs of the ports reserved for the fixed IP addresses of the interfaces.
+func interfaceFixedIPAddressPortIDs(ifs []interface{}) []string {
+	var portIDs []string
+	for _, iface := range ifs {
+		iFaceMap := iface.(map[string]interface{})
+		if fixedIPAddress, _ := iFaceMap[InstanceFixedIPAddressField].(string); fixedIPAddress == "" {
+			continue
+		}
+		if portID, _ := iFaceMap[PortIDField].(string); portID != "" {
+			portIDs = append(portIDs, portID)
+		}
+	}
+
+	return portIDs
+}
+
+// validateInterfaceFixedIPAddresses checks at plan time that the fixed IP addresses are requested only
+// for the interfaces of type 'subnet' and are inside the CIDR of the subnet.
+func validateInterfaceFixedIPAddresses(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange(InstanceInterfacesField) || !d.NewValueKnown(InstanceInterfacesField) {
+		return nil
+	}
+
+	var client *edgecloudV2.Client
+	for _, iface := range d.Get(InstanceInterfacesField).(*schema.Set).List() {
+		iFaceMap := iface.(map[string]interface{})
+		fixedIPAddress, _ := iFaceMap[InstanceFixedIPAddressField].(string)
+		if fixedIPAddress == "" {
+			continue
+		}
+		subnetID := iFaceMap[SubnetIDField].(string)
+		if iFaceMap[TypeField].(string) != string(edgecloudV2.InterfaceTypeSubnet) || subnetID == "" {
+			return fmt.Errorf("'%s' %s can be specified only for an interface of type '%s' with '%s'",
+				InstanceFixedIPAddressField, fixedIPAddress, edgecloudV2.InterfaceTypeSubnet, SubnetIDField)
+		}
+
+		if client == nil {
+			var err error
+			if client, err = instanceDiffCloudClient(ctx, d, m); err != nil {
+				return err
+			}
+		}
+		subnet, _, err := client.Subnetworks.Get(ctx, subnetID)
+		if err != nil {
+			return fmt.Errorf("cannot get subnet %s: %w", subnetID, err)
+		}
+		_, cidr, err := net.ParseCIDR(subnet.CIDR)
+		if err != nil {
+			return fmt.Errorf("cannot parse CIDR %s of subnet %s: %w", subnet.CIDR, subnetID, err)
+		}
+		if !cidr.Contains(net.ParseIP(fixedIPAddress)) {
+			return fmt.Errorf("'%s' %s is not inside the CIDR %s of subnet %s", InstanceFixedIPAddressField, fixedIPAddress, subnet.CIDR, subnetID)
+		}
+	}
+
+	return nil
+}
+
+// instanceDiffCloudClient initializes the cloud client for the region and the project of the planned resource.
+func instanceDiffCloudClient(ctx context.Context, d *schema.ResourceDiff, m interface{}) (*edgecloudV2.Client, error) {
+	client, err := m.(*Config).newCloudClient()
+	if err != nil {
+		return nil, err
+	}
+
+	regionID, err := GetRegionV2(ctx, client, d.Get(RegionIDField).(int), d.Get(RegionNameField).(string))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get region: %w", err)
+	}
+	project, err := GetProjectV2(ctx, client, d.Get(ProjectIDField).(int), d.Get(ProjectNameField).(string))
+	if err != nil {
+		return nil, err
+	}
+	client.Region = regionID
+	client.Project = project.ID
+
+	return client, nil
 }
 
 // extractInstanceInterfaceToListRead creates a list of InterfaceOpts objects from a list of interfaces.
@@ -353,9 +491,9 @@ func isInterfaceContains(verifiable map[string]interface{}, ifsSet []interface{}
 	return false
 }
 
-// ServerV2StateRefreshFuncV2 returns a StateRefreshFunc to track the state of an instance using its instanceID.
-func ServerV2StateRefreshFuncV2(ctx context.Context, client *edgecloudV2.Client, instanceID string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+// ServerV2StateRefreshFuncV2 returns a ResourceStatusFetchFunc to track the state of an instance using its instanceID.
+func ServerV2StateRefreshFuncV2(ctx context.Context, client *edgecloudV2.Client, instanceID string) ResourceStatusFetchFunc[*edgecloudV2.Instance, string] {
+	return func() (*edgecloudV2.Instance, string, error) {
 		s, _, err := client.Instances.Get(ctx, instanceID)
 		if err != nil {
 			var errDefault404 edgecloud.Default404Error
@@ -499,10 +637,22 @@ func attachInstanceV2InterfaceToInstance(ctx context.Context, client *edgecloudV
 		opts.PortID = iface[InstanceReservedFixedIPPortIDField].(string)
 	}
 
+	var reservedPortIDs []string
+	if fixedIPAddress, _ := iface[InstanceFixedIPAddressField].(string); fixedIPAddress != "" {
+		portID, err := reserveInterfaceFixedIPAddress(ctx, client, opts.SubnetID, fixedIPAddress)
+		if err != nil {
+			return err
+		}
+		reservedPortIDs = append(reservedPortIDs, portID)
+		opts.Type = edgecloudV2.InterfaceTypeReservedFixedIP
+		opts.SubnetID = ""
+		opts.PortID = portID
+	}
+
 	log.Printf("[DEBUG] attach interface: %+v", opts)
 	results, _, err := client.Instances.AttachInterface(ctx, instanceID, &opts)
 	if err != nil {
-		return fmt.Errorf("cannot attach interface: %s. Error: %w", iType, err)
+		return errors.Join(fmt.Errorf("cannot attach interface: %s. Error: %w", iType, err), releaseInterfaceFixedIPAddresses(ctx, client, reservedPortIDs))
 	}
 
 	taskID := results.Tasks[0]
@@ -512,7 +662,52 @@ func attachInstanceV2InterfaceToInstance(ctx context.Context, client *edgecloudV
 	}
 
 	if task.State == edgecloudV2.TaskStateError {
-		return fmt.Errorf("cannot attach interface with opts: %v", opts)
+		return errors.Join(fmt.Errorf("cannot attach interface with opts: %v", opts), releaseInterfaceFixedIPAddresses(ctx, client, reservedPortIDs))
+	}
+
+	return nil
+}
+
+// instanceInterfacesStatus is the status of the interface list of an instance after attaching or detaching interfaces.
+type instanceInterfacesStatus string
+
+const (
+	instanceInterfacesChanging instanceInterfacesStatus = "changing"
+	instanceInterfacesStable   instanceInterfacesStatus = "stable"
+)
+
+// waitForInstanceInterfacesStable polls the interfaces of the instance after the attach and detach tasks
+// until the detached ports are gone, every interface has an IP address and the list is the same in two polls in a row,
+// so the following read sets the actual port IDs for the resources using them in the same apply.
+func waitForInstanceInterfacesStable(ctx context.Context, client *edgecloudV2.Client, instanceID string, detachedPortIDs []string, timeout time.Duration) error {
+	var previous string
+	fetch := func() ([]edgecloudV2.InstancePortInterface, instanceInterfacesStatus, error) {
+		interfaces, _, err := client.Instances.InterfaceList(ctx, instanceID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		portIDs := make([]string, 0, len(interfaces))
+		for _, iface := range interfaces {
+			if len(iface.IPAssignments) == 0 || slices.Contains(detachedPortIDs, iface.PortID) {
+				previous = ""
+				return interfaces, instanceInterfacesChanging, nil
+			}
+			portIDs = append(portIDs, iface.PortID)
+		}
+		slices.Sort(portIDs)
+		current := strings.Join(portIDs, ",")
+		if current != previous {
+			previous = current
+			return interfaces, instanceInterfacesChanging, nil
+		}
+
+		return interfaces, instanceInterfacesStable, nil
+	}
+
+	pending := []instanceInterfacesStatus{instanceInterfacesChanging}
+	if _, err := WaitForResourceStatus(ctx, fetch, []instanceInterfacesStatus{instanceInterfacesStable}, pending, timeout, 2*time.Second); err != nil {
+		return fmt.Errorf("error waiting for interfaces of instance (%s) to become stable: %w", instanceID, err)
 	}
 
 	return nil
@@ -577,6 +772,72 @@ func adjustAllPortsSecurityDisabledOpt(ctx context.Context, client *edgecloudV2.
 	return diags
 }
 
+// InstanceNoValidHostErrMsg is the error of the instance creation task when the scheduler can't find a host for the instance.
+const InstanceNoValidHostErrMsg = "No valid host was found"
+
+// isInstancePlacementError reports whether the instance creation failed because the instance can't be placed on any host.
+func isInstancePlacementError(err error) bool {
+	return strings.Contains(err.Error(), InstanceNoValidHostErrMsg)
+}
+
+// createInstanceV2 creates the instance and waits for the task. If the task fails,
+// the IDs of the instances it has created, e.g. in the ERROR state, are returned with the error.
+func createInstanceV2(ctx context.Context, client *edgecloudV2.Client, opts *edgecloudV2.InstanceCreateRequest, timeout time.Duration) (*utilV2.TaskResult, []string, error) {
+	results, _, err := client.Instances.Create(ctx, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	taskID := results.Tasks[0]
+
+	taskInfo, err := utilV2.WaitAndGetTaskInfo(ctx, client, taskID, timeout)
+	if err != nil {
+		var createdIDs []string
+		if task, _, getErr := client.Tasks.Get(ctx, taskID); getErr == nil {
+			if created, extractErr := utilV2.ExtractTaskResultFromTask(task); extractErr == nil {
+				createdIDs = created.Instances
+			}
+		}
+		return nil, createdIDs, err
+	}
+
+	result, err := utilV2.ExtractTaskResultFromTask(taskInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, nil, nil
+}
+
+// deleteInstancesV2 deletes the instances and waits for their deletion.
+func deleteInstancesV2(ctx context.Context, client *edgecloudV2.Client, instanceIDs []string, timeout time.Duration) error {
+	for _, instanceID := range instanceIDs {
+		log.Printf("[DEBUG] Deleting instance %s", instanceID)
+		results, _, err := client.Instances.Delete(ctx, instanceID, nil)
+		if err != nil {
+			return fmt.Errorf("cannot delete instance %s: %w", instanceID, err)
+		}
+		if err := utilV2.WaitForTaskComplete(ctx, client, results.Tasks[0], timeout); err != nil {
+			return fmt.Errorf("cannot delete instance %s: %w", instanceID, err)
+		}
+	}
+
+	return nil
+}
+
+// isAntiAffinityServerGroup reports whether the server group has the anti-affinity policy.
+func isAntiAffinityServerGroup(ctx context.Context, client *edgecloudV2.Client, sgID string) (bool, error) {
+	if sgID == "" {
+		return false, nil
+	}
+
+	serverGroup, _, err := client.ServerGroups.Get(ctx, sgID)
+	if err != nil {
+		return false, fmt.Errorf("cannot get servergroup %s: %w", sgID, err)
+	}
+
+	return serverGroup.Policy == edgecloudV2.ServerGroupPolicyAntiAffinity, nil
+}
+
 // deleteServerGroupV2 removes a server group from an instance.
 func deleteServerGroupV2(ctx context.Context, client *edgecloudV2.Client, instanceID string) error {
 	log.Printf("[DEBUG] remove server group from instance: %s", instanceID)
@@ -643,6 +904,7 @@ func removeSecurityGroupFromInstanceV2(ctx context.Context, client *edgecloudV2.
 }
 
 // removeSecurityGroupsFromInstancePort removes one or more security groups from a specific instance port.
+// The request is retried while the API responds with a conflict.
 func removeSecurityGroupsFromInstancePort(ctx context.Context, client *edgecloudV2.Client, instanceID, portID string, removeSGIDs []interface{}) error {
 	if len(removeSGIDs) == 0 {
 		return nil
@@ -655,34 +917,51 @@ func removeSecurityGroupsFromInstancePort(ctx context.Context, client *edgecloud
 	if err != nil {
 		return err
 	}
-	_, err = client.Instances.SecurityGroupUnAssign(ctx, instanceID, removeSGOpts)
-	if err != nil {
-		return err
-	}
 
-	return nil
+	return retryOnConflict(ctx, func(ctx context.Context) error {
+		resp, err := client.Instances.SecurityGroupUnAssign(ctx, instanceID, removeSGOpts)
+		return ClassifyAPIError(resp, err)
+	})
 }
 
 // AssignSecurityGroupsToInstancePort assigns one or more security groups to a specific instance port.
+// Each attempt assigns only the security groups which are not assigned to the port yet, so a conflict caused by
+// an already assigned security group resolves itself and re-applying after a partial failure converges.
+// The request is retried while the API responds with a conflict or a transient failure.
 func AssignSecurityGroupsToInstancePort(ctx context.Context, client *edgecloudV2.Client, instanceID, portID string, assignSGIDs []interface{}) error {
 	if len(assignSGIDs) == 0 {
 		return nil
 	}
-	sgsToAssign := make([]string, 0, len(assignSGIDs))
-	for _, sg := range assignSGIDs {
-		sgsToAssign = append(sgsToAssign, sg.(string))
-	}
 
-	removeSGOpts, err := PrepareAndValidateAssignSecurityGroupRequestOpts(ctx, client, sgsToAssign, portID)
-	if err != nil {
-		return err
-	}
-	_, err = client.Instances.SecurityGroupAssign(ctx, instanceID, removeSGOpts)
-	if err != nil {
-		return err
-	}
+	return retryOnTransientError(ctx, func(ctx context.Context) error {
+		port, err := utilV2.InstanceNetworkPortByID(ctx, client, instanceID, portID)
+		if err != nil {
+			return err
+		}
+		assigned := make(map[string]bool, len(port.SecurityGroups))
+		for _, sg := range port.SecurityGroups {
+			assigned[sg.ID] = true
+		}
 
-	return nil
+		sgsToAssign := make([]string, 0, len(assignSGIDs))
+		for _, sg := range assignSGIDs {
+			if !assigned[sg.(string)] {
+				sgsToAssign = append(sgsToAssign, sg.(string))
+			}
+		}
+		if len(sgsToAssign) == 0 {
+			log.Printf("[DEBUG] security groups %v are already assigned to port %s", assignSGIDs, portID)
+			return nil
+		}
+
+		assignSGOpts, err := PrepareAndValidateAssignSecurityGroupRequestOpts(ctx, client, sgsToAssign, portID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Instances.SecurityGroupAssign(ctx, instanceID, assignSGOpts)
+		return ClassifyAPIError(resp, err)
+	})
 }
 
 func PrepareAndValidateAssignSecurityGroupRequestOpts(ctx context.Context, client *edgecloudV2.Client, sgIDs []string, portID string) (*edgecloudV2.AssignSecurityGroupRequest, error) {
@@ -766,6 +1045,93 @@ func getSecurityGroupsIDsV2(sgsRaw []interface{}) []edgecloudV2.ID {
 	return sgs
 }
 
+// resolveInterfaceSecurityGroupNames returns a copy of the interface with the IDs of the security groups
+// of security_group_names added to security_groups, see resolveSecurityGroupIDs.
+func resolveInterfaceSecurityGroupNames(ctx context.Context, client *edgecloudV2.Client, m interface{}, iFace map[string]interface{}) (map[string]interface{}, error) {
+	sgNames, ok := iFace[SecurityGroupNamesField].(*schema.Set)
+	if !ok || sgNames.Len() == 0 {
+		return iFace, nil
+	}
+
+	names := make([]string, 0, sgNames.Len())
+	for _, name := range sgNames.List() {
+		names = append(names, name.(string))
+	}
+	resolvedIDs, err := resolveSecurityGroupIDs(ctx, client, m, names)
+	if err != nil {
+		return nil, err
+	}
+
+	sgIDs, _ := iFace["security_groups"].([]interface{})
+	for _, id := range resolvedIDs {
+		if !slices.Contains(sgIDs, interface{}(id)) {
+			sgIDs = append(sgIDs, id)
+		}
+	}
+
+	return withInterfaceSecurityGroups(iFace, sgIDs), nil
+}
+
+// withAttachedInterfaceSecurityGroups returns a copy of the interface of the prior state with the IDs of
+// the security groups attached to its port by the names of security_group_names added to security_groups.
+// The attached security groups are matched by name instead of a lookup, so a security group renamed since
+// stays attached and another security group which has got its name is never detached.
+func withAttachedInterfaceSecurityGroups(m interface{}, iFace map[string]interface{}, ports []edgecloudV2.InstancePort) map[string]interface{} {
+	sgNames, ok := iFace[SecurityGroupNamesField].(*schema.Set)
+	if !ok || sgNames.Len() == 0 {
+		return iFace
+	}
+	port, err := findInstancePortV2(iFace["port_id"].(string), ports)
+	if err != nil {
+		return iFace
+	}
+
+	sgIDs, _ := iFace["security_groups"].([]interface{})
+	for _, sg := range port.SecurityGroups {
+		if sgNames.Contains(withoutNamePrefix(m, sg.Name)) && !slices.Contains(sgIDs, interface{}(sg.ID)) {
+			sgIDs = append(sgIDs, sg.ID)
+		}
+	}
+
+	return withInterfaceSecurityGroups(iFace, sgIDs)
+}
+
+func withInterfaceSecurityGroups(iFace map[string]interface{}, sgIDs []interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(iFace))
+	for k, v := range iFace {
+		result[k] = v
+	}
+	result["security_groups"] = sgIDs
+
+	return result
+}
+
+// splitInterfaceSecurityGroups returns the IDs and the names of the security groups of the interface port
+// in the form of the interface block: the security groups specified by name in the configured interface
+// are returned by name, the other ones by ID.
+func splitInterfaceSecurityGroups(m interface{}, iFace map[string]interface{}, sgs []edgecloudV2.IDName) ([]string, *schema.Set) {
+	configuredIDs, _ := iFace["security_groups"].([]interface{})
+	configuredNames, ok := iFace[SecurityGroupNamesField].(*schema.Set)
+	if !ok {
+		configuredNames = schema.NewSet(schema.HashString, nil)
+	}
+
+	sgIDs := make([]string, 0, len(sgs))
+	sgNames := schema.NewSet(schema.HashString, nil)
+	for _, sg := range sgs {
+		name := withoutNamePrefix(m, sg.Name)
+		byName := configuredNames.Contains(name)
+		if byName {
+			sgNames.Add(name)
+		}
+		if !byName || slices.Contains(configuredIDs, interface{}(sg.ID)) {
+			sgIDs = append(sgIDs, sg.ID)
+		}
+	}
+
+	return sgIDs, sgNames
+}
+
 // getSecurityGroupsDifferenceV2 finds the difference between two slices of edgecloudV2.ID.
 func getSecurityGroupsDifferenceV2(sl1, sl2 []edgecloudV2.ID) (diff []edgecloudV2.ID) { // nolint: nonamedreturns
 	set := make(map[string]bool)
@@ -825,10 +1191,13 @@ func validateInterfaceAttrs(d *schema.ResourceData) diag.Diagnostics {
 				isSecGroupExists = true
 			}
 		}
+		if v, ok := iNew[SecurityGroupNamesField].(*schema.Set); ok && v.Len() != 0 {
+			isSecGroupExists = true
+		}
 		if isPortSecDisabled && isSecGroupExists {
 			curDiag := diag.Diagnostic{
 				Severity:      diag.Error,
-				Summary:       fmt.Sprintf("if attribute \"port_security_disabled\" for interface %+v set true, you can't set \"security_groups\" attribute", iNew),
+				Summary:       fmt.Sprintf("if attribute \"port_security_disabled\" for interface %+v set true, you can't set \"security_groups\" or \"security_group_names\" attribute", iNew),
 				Detail:        "",
 				AttributePath: nil,
 			}
@@ -885,9 +1254,78 @@ func validateBootVolumeAttrs(ctx context.Context, clientV2 *edgecloudV2.Client,
 	return diags
 }
 
-// VolumeV2StateRefreshFuncV2 returns a StateRefreshFunc to track the state of attaching volume using its volumeID.
-func VolumeV2StateRefreshFuncV2(ctx context.Context, client *edgecloudV2.Client, volumeID string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+// instanceFirstIPAddresses returns the first IPv4 and IPv6 addresses of the instance interfaces in the order of attaching.
+func instanceFirstIPAddresses(ifs []edgecloudV2.InstancePortInterface) (string, string) {
+	var ipv4, ipv6 string
+	for _, iface := range ifs {
+		for _, assignment := range iface.IPAssignments {
+			switch {
+			case assignment.IPAddress == nil:
+			case assignment.IPAddress.To4() != nil:
+				if ipv4 == "" {
+					ipv4 = assignment.IPAddress.String()
+				}
+			case ipv6 == "":
+				ipv6 = assignment.IPAddress.String()
+			}
+		}
+	}
+
+	return ipv4, ipv6
+}
+
+// instanceDeleteOptions returns the options to delete the instance. When the purge_ports_on_instance_delete
+// feature is enabled, floating IPs and reserved fixed IPs of the interfaces are deleted together with the instance.
+func instanceDeleteOptions(config *Config, ifs []interface{}, portIDKey string) *edgecloudV2.InstanceDeleteOptions {
+	var opts edgecloudV2.InstanceDeleteOptions
+	if !config.Features.PurgePortsOnInstanceDelete {
+		return &opts
+	}
+
+	opts.DeleteFloatings = true
+	for _, iface := range ifs {
+		iFaceMap := iface.(map[string]interface{})
+		if iFaceMap[TypeField].(string) != string(edgecloudV2.InterfaceTypeReservedFixedIP) {
+			continue
+		}
+		if portID, _ := iFaceMap[portIDKey].(string); portID != "" {
+			opts.ReservedFixedIPs = append(opts.ReservedFixedIPs, portID)
+		}
+	}
+
+	return &opts
+}
+
+// detachInstanceFloatingIPsAndVolumes unassigns the floating IPs of the instance and detaches the volumes from it,
+// so they are not affected by the instance deletion.
+func detachInstanceFloatingIPsAndVolumes(ctx context.Context, client *edgecloudV2.Client, instanceID string, volumeIDs []string, timeout time.Duration) error {
+	floatingIPs, _, err := client.Floatingips.List(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get floating IPs: %w", err)
+	}
+	for _, fip := range floatingIPs {
+		if fip.Instance.ID != instanceID {
+			continue
+		}
+		log.Printf("[DEBUG] Unassign floating IP %s from instance %s", fip.ID, instanceID)
+		if _, _, err := client.Floatingips.UnAssign(ctx, fip.ID); err != nil {
+			return fmt.Errorf("cannot unassign floating IP %s from instance %s: %w", fip.ID, instanceID, err)
+		}
+	}
+
+	for _, volumeID := range volumeIDs {
+		log.Printf("[DEBUG] Detach volume %s from instance %s", volumeID, instanceID)
+		if err := detachVolumeFromInstance(ctx, client, volumeID, instanceID, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// VolumeV2StateRefreshFuncV2 returns a ResourceStatusFetchFunc to track the state of attaching volume using its volumeID.
+func VolumeV2StateRefreshFuncV2(ctx context.Context, client *edgecloudV2.Client, volumeID string) ResourceStatusFetchFunc[*edgecloudV2.Volume, string] {
+	return func() (*edgecloudV2.Volume, string, error) {
 		volume, _, err := client.Volumes.Get(ctx, volumeID)
 		if err != nil {
 			var errDefault404 edgecloud.Default404Error
@@ -905,7 +1343,7 @@ func VolumeV2StateRefreshFuncV2(ctx context.Context, client *edgecloudV2.Client,
 	}
 }
 
-func EnrichVolumeData(instanceVolumes []edgecloudV2.Volume, volumesState map[string]map[string]interface{}) []interface{} {
+func EnrichVolumeData(instanceID string, instanceVolumes []edgecloudV2.Volume, volumesState map[string]map[string]interface{}) []interface{} {
 	enrichedVolumesData := make([]interface{}, 0, len(instanceVolumes))
 	for _, vol := range instanceVolumes {
 		v := make(map[string]interface{})
@@ -923,6 +1361,11 @@ func EnrichVolumeData(instanceVolumes []edgecloudV2.Volume, volumesState map[str
 		v["type_name"] = vol.VolumeType
 		v["size"] = vol.Size
 		v["name"] = vol.Name
+		for _, attachment := range vol.Attachments {
+			if attachment.ServerID == instanceID {
+				v[InstanceVolumeDeviceField] = attachment.Device
+			}
+		}
 		enrichedVolumesData = append(enrichedVolumesData, v)
 	}
 
@@ -972,15 +1415,8 @@ func UpdateVolumes(ctx context.Context, d *schema.ResourceData, client *edgeclou
 			if _, _, err := client.Volumes.Attach(ctx, vid, &vAttachOpts); err != nil {
 				return err
 			}
-			startStateConf := &retry.StateChangeConf{
-				Target:     []string{instanceID},
-				Refresh:    VolumeV2StateRefreshFuncV2(ctx, client, vid),
-				Timeout:    d.Timeout(schema.TimeoutUpdate),
-				Delay:      2 * time.Second,
-				MinTimeout: 3 * time.Second,
-			}
-			_, err := startStateConf.WaitForStateContext(ctx)
-			if err != nil {
+			fetch := VolumeV2StateRefreshFuncV2(ctx, client, vid)
+			if _, err := WaitForResourceStatus(ctx, fetch, []string{instanceID}, nil, d.Timeout(schema.TimeoutUpdate), 2*time.Second); err != nil {
 				return fmt.Errorf("error waiting for volume (%s) to become attached: %w", vid, err)
 			}
 		}
@@ -1149,3 +1585,214 @@ func checkIfaceAttrCombinations(ifaces []interface{}) error {
 
 	return nil
 }
+
+// shutdownInstanceBeforeDelete stops the active instance and waits up to the given timeout for it to stop,
+// so the workloads on it can shut down gracefully before the instance is deleted.
+// The instance is deleted anyway if it does not stop in time.
+func shutdownInstanceBeforeDelete(ctx context.Context, client *edgecloudV2.Client, instanceID string, timeout time.Duration) error {
+	instance, _, err := client.Instances.Get(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	if instance.VMState != InstanceVMStateActive {
+		return nil
+	}
+
+	if _, _, err := client.Instances.InstanceStop(ctx, instanceID); err != nil {
+		return err
+	}
+
+	fetch := ServerV2StateRefreshFuncV2(ctx, client, instanceID)
+	if _, err := WaitForResourceStatus(ctx, fetch, []string{InstanceVMStateStopped}, nil, timeout, 0); err != nil {
+		log.Printf("[WARN] Instance (%s) did not stop in %s, deleting it anyway: %s", instanceID, timeout, err)
+	}
+
+	return nil
+}
+
+// stopInstanceForUpdate stops the active instance before an update that requires it to be stopped,
+// if the allow_stopped_update field is set. It returns true if the instance has been stopped
+// and has to be started again after the update.
+func stopInstanceForUpdate(ctx context.Context, client *edgecloudV2.Client, d *schema.ResourceData, now time.Time) (bool, error) {
+	if !d.Get(InstanceAllowStoppedUpdateField).(bool) {
+		return false, nil
+	}
+
+	instanceID := d.Id()
+	instance, _, err := client.Instances.Get(ctx, instanceID)
+	if err != nil {
+		return false, err
+	}
+	if instance.VMState != InstanceVMStateActive {
+		return false, nil
+	}
+
+	if window := d.Get(InstanceStoppedUpdateWindowField).(string); window != "" {
+		inWindow, err := inMaintenanceWindow(window, now)
+		if err != nil {
+			return false, err
+		}
+		if !inWindow {
+			return false, fmt.Errorf("instance %s must be stopped to be updated, but the current time %s is outside %s %q",
+				instanceID, now.UTC().Format("15:04"), InstanceStoppedUpdateWindowField, window)
+		}
+	}
+
+	log.Printf("[DEBUG] Stopping instance (%s) to update it", instanceID)
+	if _, _, err := client.Instances.InstanceStop(ctx, instanceID); err != nil {
+		return false, err
+	}
+	fetch := ServerV2StateRefreshFuncV2(ctx, client, instanceID)
+	if _, err := WaitForResourceStatus(ctx, fetch, []string{InstanceVMStateStopped}, nil, d.Timeout(schema.TimeoutUpdate), 10*time.Second); err != nil {
+		return false, fmt.Errorf("error waiting for instance (%s) to stop: %w", instanceID, err)
+	}
+
+	return true, nil
+}
+
+// updateInstanceFlavorV2 changes the flavor of the instance and waits for the task to complete.
+func updateInstanceFlavorV2(ctx context.Context, client *edgecloudV2.Client, instanceID, flavorID string, timeout time.Duration) error {
+	result, _, err := client.Instances.UpdateFlavor(ctx, instanceID, &edgecloudV2.InstanceFlavorUpdateRequest{FlavorID: flavorID})
+	if err != nil {
+		return err
+	}
+	if len(result.Tasks) == 0 {
+		return fmt.Errorf("no task returned for the flavor update of instance with ID: %s", instanceID)
+	}
+	taskID := result.Tasks[0]
+	log.Printf("[DEBUG] Task id (%s)", taskID)
+	task, err := utilV2.WaitAndGetTaskInfo(ctx, client, taskID, timeout)
+	if err != nil {
+		return err
+	}
+	if task.State == edgecloudV2.TaskStateError {
+		return fmt.Errorf("cannot update flavor in instance with ID: %s", instanceID)
+	}
+
+	return nil
+}
+
+// startInstanceAfterUpdate starts the instance stopped by stopInstanceForUpdate.
+func startInstanceAfterUpdate(ctx context.Context, client *edgecloudV2.Client, instanceID string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Starting instance (%s) after update", instanceID)
+	if _, _, err := client.Instances.InstanceStart(ctx, instanceID); err != nil {
+		return err
+	}
+	fetch := ServerV2StateRefreshFuncV2(ctx, client, instanceID)
+	if _, err := WaitForResourceStatus(ctx, fetch, []string{InstanceVMStateActive}, nil, timeout, 10*time.Second); err != nil {
+		return fmt.Errorf("error waiting for instance (%s) to become active: %w", instanceID, err)
+	}
+
+	return nil
+}
+
+// shelveInstance shelves the instance, which releases its compute resources but keeps its volumes and addresses.
+// The instance stays in the shelved state until the cloud offloads it, which depends on the region settings
+// and may never happen, so both shelved states complete the wait.
+func shelveInstance(ctx context.Context, client *edgecloudV2.Client, instanceID string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Shelving instance (%s)", instanceID)
+	if err := instanceAction(ctx, client, instanceID, "shelve"); err != nil {
+		return err
+	}
+	fetch := ServerV2StateRefreshFuncV2(ctx, client, instanceID)
+	if _, err := WaitForResourceStatus(ctx, fetch, []string{InstanceVMStateShelved, InstanceVMStateShelvedOffloaded}, nil, timeout, 10*time.Second); err != nil {
+		return fmt.Errorf("error waiting for instance (%s) to be shelved: %w", instanceID, err)
+	}
+
+	return nil
+}
+
+// unshelveInstance unshelves the instance and waits for it to become active.
+func unshelveInstance(ctx context.Context, client *edgecloudV2.Client, instanceID string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Unshelving instance (%s)", instanceID)
+	if err := instanceAction(ctx, client, instanceID, "unshelve"); err != nil {
+		return err
+	}
+	fetch := ServerV2StateRefreshFuncV2(ctx, client, instanceID)
+	if _, err := WaitForResourceStatus(ctx, fetch, []string{InstanceVMStateActive}, nil, timeout, 10*time.Second); err != nil {
+		return fmt.Errorf("error waiting for instance (%s) to become active: %w", instanceID, err)
+	}
+
+	return nil
+}
+
+// instanceAction sends the action request of the instance, which the client doesn't cover, the same way as InstanceSuspend.
+// The client has no shelve and unshelve methods, so they are sent with it.
+func instanceAction(ctx context.Context, client *edgecloudV2.Client, instanceID, action string) error {
+	path := fmt.Sprintf("/v1/instances/%d/%d/%s/%s", client.Project, client.Region, instanceID, action)
+	req, err := client.NewRequest(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("cannot %s instance %s: %w", action, instanceID, err)
+	}
+
+	return nil
+}
+
+// instanceVMState returns the state of the instance as it is set in the vm_state field.
+func instanceVMState(vmState string) string {
+	if vmState == InstanceVMStateShelvedOffloaded {
+		return InstanceVMStateShelved
+	}
+
+	return vmState
+}
+
+// parseMaintenanceWindow parses the window in the format 'HH:MM-HH:MM'
+// and returns its bounds in minutes since midnight.
+func parseMaintenanceWindow(window string) (int, int, error) {
+	bounds := strings.Split(window, "-")
+	if len(bounds) != 2 {
+		return 0, 0, fmt.Errorf("invalid window %q, expected the format 'HH:MM-HH:MM'", window)
+	}
+
+	minutes := make([]int, 2)
+	for i, bound := range bounds {
+		t, err := time.Parse("15:04", strings.TrimSpace(bound))
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid window %q, expected the format 'HH:MM-HH:MM': %w", window, err)
+		}
+		minutes[i] = t.Hour()*60 + t.Minute()
+	}
+
+	return minutes[0], minutes[1], nil
+}
+
+// inMaintenanceWindow checks whether the time falls into the window in UTC.
+// The window may span midnight, e.g. '22:00-02:00'.
+func inMaintenanceWindow(window string, now time.Time) (bool, error) {
+	start, end, err := parseMaintenanceWindow(window)
+	if err != nil {
+		return false, err
+	}
+
+	now = now.UTC()
+	current := now.Hour()*60 + now.Minute()
+	if start <= end {
+		return current >= start && current < end, nil
+	}
+
+	return current >= start || current < end, nil
+}
+
+func validateMaintenanceWindow(v interface{}, k string) ([]string, []error) {
+	if _, _, err := parseMaintenanceWindow(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %w", k, err)}
+	}
+
+	return nil, nil
+}
+
+// hostnameCloudConfig returns the cloud-config setting the hostname of the instance on the first boot.
+// If the hostname is a FQDN, the short hostname is its first label.
+func hostnameCloudConfig(hostname string) string {
+	short, _, _ := strings.Cut(hostname, ".")
+	config := fmt.Sprintf("#cloud-config\nhostname: %s\n", short)
+	if short != hostname {
+		config += fmt.Sprintf("fqdn: %s\n", hostname)
+	}
+
+	return config
+}
@@ -0,0 +1,137 @@
+package edgecenter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func TestParseMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		window    string
+		wantStart int
+		wantEnd   int
+		wantErr   bool
+	}{
+		{name: "same day", window: "01:30-04:00", wantStart: 90, wantEnd: 240},
+		{name: "crossing midnight", window: "22:00-02:00", wantStart: 1320, wantEnd: 120},
+		{name: "spaces around bounds", window: " 22:00 - 02:00 ", wantStart: 1320, wantEnd: 120},
+		{name: "empty", window: "", wantErr: true},
+		{name: "single bound", window: "22:00", wantErr: true},
+		{name: "too many bounds", window: "01:00-02:00-03:00", wantErr: true},
+		{name: "invalid hour", window: "25:00-02:00", wantErr: true},
+		{name: "invalid minute", window: "22:00-02:60", wantErr: true},
+		{name: "not a time", window: "night-morning", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			start, end, err := parseMaintenanceWindow(tt.window)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseMaintenanceWindow(%q) = %d, %d, want an error", tt.window, start, end)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseMaintenanceWindow(%q) returned an error: %s", tt.window, err)
+			}
+			if start != tt.wantStart || end != tt.wantEnd {
+				t.Errorf("parseMaintenanceWindow(%q) = %d, %d, want %d, %d", tt.window, start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestInMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 7, 1, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name    string
+		window  string
+		now     time.Time
+		want    bool
+		wantErr bool
+	}{
+		{name: "inside same day window", window: "01:00-04:00", now: at(2, 30), want: true},
+		{name: "at the start", window: "01:00-04:00", now: at(1, 0), want: true},
+		{name: "at the end", window: "01:00-04:00", now: at(4, 0), want: false},
+		{name: "before same day window", window: "01:00-04:00", now: at(0, 59), want: false},
+		{name: "after same day window", window: "01:00-04:00", now: at(12, 0), want: false},
+		{name: "before midnight in crossing window", window: "22:00-02:00", now: at(23, 30), want: true},
+		{name: "after midnight in crossing window", window: "22:00-02:00", now: at(1, 0), want: true},
+		{name: "at midnight in crossing window", window: "22:00-02:00", now: at(0, 0), want: true},
+		{name: "outside crossing window", window: "22:00-02:00", now: at(12, 0), want: false},
+		{name: "at the end of crossing window", window: "22:00-02:00", now: at(2, 0), want: false},
+		{
+			name:   "non-UTC time is converted",
+			window: "22:00-02:00",
+			// 03:30 at UTC+3 is 00:30 UTC
+			now:  time.Date(2024, 7, 1, 3, 30, 0, 0, time.FixedZone("UTC+3", 3*60*60)),
+			want: true,
+		},
+		{name: "invalid window", window: "22:00", now: at(23, 0), wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := inMaintenanceWindow(tt.window, tt.now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("inMaintenanceWindow(%q, %s) = %t, want an error", tt.window, tt.now, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("inMaintenanceWindow(%q, %s) returned an error: %s", tt.window, tt.now, err)
+			}
+			if got != tt.want {
+				t.Errorf("inMaintenanceWindow(%q, %s) = %t, want %t", tt.window, tt.now, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitInterfaceSecurityGroups(t *testing.T) {
+	t.Parallel()
+
+	sgs := []edgecloudV2.IDName{
+		{ID: "id-default", Name: "default"},
+		{ID: "id-web", Name: "web"},
+		{ID: "id-db", Name: "db"},
+	}
+	iFace := map[string]interface{}{
+		"security_groups":       []interface{}{"id-default", "id-db"},
+		SecurityGroupNamesField: schema.NewSet(schema.HashString, []interface{}{"web", "db"}),
+	}
+
+	sgIDs, sgNames := splitInterfaceSecurityGroups(&Config{}, iFace, sgs)
+
+	if want := []string{"id-default", "id-db"}; !reflect.DeepEqual(sgIDs, want) {
+		t.Errorf("security group IDs = %v, want %v", sgIDs, want)
+	}
+	if want := schema.NewSet(schema.HashString, []interface{}{"web", "db"}); !sgNames.Equal(want) {
+		t.Errorf("security group names = %v, want %v", sgNames.List(), want.List())
+	}
+
+	// without the names in the configuration, e.g. after import, all the security groups are read by ID
+	sgIDs, sgNames = splitInterfaceSecurityGroups(&Config{}, nil, sgs)
+	if want := []string{"id-default", "id-web", "id-db"}; !reflect.DeepEqual(sgIDs, want) {
+		t.Errorf("security group IDs = %v, want %v", sgIDs, want)
+	}
+	if sgNames.Len() != 0 {
+		t.Errorf("security group names = %v, want none", sgNames.List())
+	}
+}
@@ -1,13 +1,32 @@
 package edgecenter
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
 	"net"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"gopkg.in/yaml.v3"
 
 	edgecloud "github.com/Edge-Center/edgecentercloud-go"
+	"github.com/Edge-Center/edgecentercloud-go/edgecenter/k8s/v1/clusters"
 )
 
+const k8sClientKeyBits = 2048
+
+// k8sClientCertificateSubject is the subject of the rotated client certificates,
+// the same as the one of the client certificate of the kubeconfig issued by the cloud, the cluster admin.
+var k8sClientCertificateSubject = pkix.Name{CommonName: "admin", Organization: []string{"system:masters"}}
+
+// k8sKubeconfigFields are the fields of the k8s resource which are issued together with the kubeconfig.
+var k8sKubeconfigFields = []string{"kubeconfig", "certificate_authority_data", "client_certificate_data", "client_key_data"}
+
 func parseCIDRFromString(cidr string) (edgecloud.CIDR, error) {
 	var ecCIDR edgecloud.CIDR
 	_, netIPNet, err := net.ParseCIDR(cidr)
@@ -59,3 +78,83 @@ func parseK8sConfig(data string) (*K8sConfig, error) {
 	}
 	return &config, nil
 }
+
+// setK8sKubeconfig gets the kubeconfig of the cluster and sets it with its parts.
+// If rotate is true, the client key and certificate of the kubeconfig are replaced with new ones.
+func setK8sKubeconfig(client *edgecloud.ServiceClient, d *schema.ResourceData, clusterID string, rotate bool) error {
+	getConfigResult, err := clusters.GetConfig(client, clusterID).Extract()
+	if err != nil {
+		return fmt.Errorf("cannot get kubeconfig of cluster %s: %w", clusterID, err)
+	}
+
+	kubeconfig := getConfigResult.Config
+	if rotate {
+		kubeconfig, err = rotateK8sClientCertificate(client, clusterID, kubeconfig)
+		if err != nil {
+			return fmt.Errorf("cannot rotate kubeconfig of cluster %s: %w", clusterID, err)
+		}
+	}
+
+	clusterConfig, err := parseK8sConfig(kubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed to parse k8s config: %w", err)
+	}
+	if len(clusterConfig.Clusters) == 0 || len(clusterConfig.Users) == 0 {
+		return fmt.Errorf("kubeconfig of cluster %s has no clusters or users", clusterID)
+	}
+
+	d.Set("kubeconfig", kubeconfig)
+	d.Set("certificate_authority_data", clusterConfig.Clusters[0].Cluster.CertificateAuthorityData)
+	d.Set("client_certificate_data", clusterConfig.Users[0].User.ClientCertificateData)
+	d.Set("client_key_data", clusterConfig.Users[0].User.ClientKeyData)
+
+	return nil
+}
+
+// rotateK8sClientCertificate generates a new client key, gets its certificate signed by the cluster CA
+// and returns the kubeconfig with the new key and certificate of its users.
+func rotateK8sClientCertificate(client *edgecloud.ServiceClient, clusterID, kubeconfig string) (string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, k8sClientKeyBits)
+	if err != nil {
+		return "", err
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: k8sClientCertificateSubject}, key)
+	if err != nil {
+		return "", err
+	}
+	opts := clusters.ClusterSignCertificateOpts{
+		CSR: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})),
+	}
+	certificate, err := clusters.SignCertificate(client, clusterID, opts).Extract()
+	if err != nil {
+		return "", err
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	// the kubeconfig is changed as a generic document, so that the fields missing in K8sConfig are kept
+	var config map[string]interface{}
+	if err := yaml.Unmarshal([]byte(kubeconfig), &config); err != nil {
+		return "", fmt.Errorf("failed to parse k8s config: %w", err)
+	}
+	users, _ := config["users"].([]interface{})
+	if len(users) == 0 {
+		return "", errors.New("kubeconfig has no users")
+	}
+	for _, rawUser := range users {
+		user, _ := rawUser.(map[string]interface{})
+		fields, ok := user["user"].(map[string]interface{})
+		if !ok {
+			return "", errors.New("kubeconfig has a user without credentials")
+		}
+		fields["client-certificate-data"] = base64.StdEncoding.EncodeToString([]byte(certificate.PEM))
+		fields["client-key-data"] = base64.StdEncoding.EncodeToString(keyPEM)
+	}
+
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
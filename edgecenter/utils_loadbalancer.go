@@ -1,11 +1,17 @@
 package edgecenter
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net"
+	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
@@ -118,3 +124,118 @@ func extractListenerIntoMapV2(listener *edgecloudV2.Listener) map[string]interfa
 	l["sni_secret_id"] = listener.SNISecretID
 	return l
 }
+
+// lbVipIPv6Address returns the VIP address if it is IPv6, the API allocates a single VIP address,
+// so the load balancer is either IPv4 or IPv6.
+func lbVipIPv6Address(vip net.IP) string {
+	if vip == nil || vip.To4() != nil {
+		return ""
+	}
+
+	return vip.String()
+}
+
+// lbMemberAddress returns the address of the pool member, empty if the API returns none,
+// since net.IP reports the missing address as "<nil>".
+func lbMemberAddress(address net.IP) string {
+	if address == nil {
+		return ""
+	}
+
+	return address.String()
+}
+
+// validateHTTPExpectedCodes checks that the expected codes of the health monitor are HTTP status codes,
+// a comma-separated list of them or a range, e.g. '200', '200,202' or '200-204'.
+func validateHTTPExpectedCodes(v interface{}, k string) ([]string, []error) {
+	codes := v.(string)
+	parseCode := func(raw string) (int, error) {
+		code, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || code < 100 || code > 599 {
+			return 0, fmt.Errorf("%s: %q is not an HTTP status code", k, raw)
+		}
+		return code, nil
+	}
+
+	if bounds := strings.Split(codes, "-"); len(bounds) == 2 {
+		low, err := parseCode(bounds[0])
+		if err != nil {
+			return nil, []error{err}
+		}
+		high, err := parseCode(bounds[1])
+		if err != nil {
+			return nil, []error{err}
+		}
+		if low > high {
+			return nil, []error{fmt.Errorf("%s: the range %q is reversed", k, codes)}
+		}
+		return nil, nil
+	}
+
+	for _, code := range strings.Split(codes, ",") {
+		if _, err := parseCode(code); err != nil {
+			return nil, []error{err}
+		}
+	}
+
+	return nil, nil
+}
+
+// lbConnectionsStatus is the status of the active connections of a load balancer.
+type lbConnectionsStatus string
+
+const (
+	lbConnectionsDrained  lbConnectionsStatus = "drained"
+	lbConnectionsDraining lbConnectionsStatus = "draining"
+)
+
+// waitForLBConnectionsDrained waits until the load balancers of the pool have no active connections.
+// The API only reports statistics per load balancer, so the connections of the other members count too.
+// Reaching the timeout is not an error, the member is considered drained anyway.
+func waitForLBConnectionsDrained(ctx context.Context, client *edgecloudV2.Client, pool *edgecloudV2.Pool, timeout time.Duration) error {
+	for _, lb := range pool.Loadbalancers {
+		fetch := lbConnectionsStateRefreshFunc(ctx, client, lb.ID)
+		_, err := WaitForResourceStatus(ctx, fetch, []lbConnectionsStatus{lbConnectionsDrained}, []lbConnectionsStatus{lbConnectionsDraining}, timeout, 0)
+		var timeoutErr *retry.TimeoutError
+		switch {
+		case errors.As(err, &timeoutErr):
+			log.Printf("[WARN] Load balancer %s still has active connections after %s", lb.ID, timeout)
+		case err != nil:
+			return fmt.Errorf("error waiting for load balancer (%s) connections to drain: %w", lb.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func lbConnectionsStateRefreshFunc(ctx context.Context, client *edgecloudV2.Client, lbID string) ResourceStatusFetchFunc[*edgecloudV2.Loadbalancer, lbConnectionsStatus] {
+	return func() (*edgecloudV2.Loadbalancer, lbConnectionsStatus, error) {
+		lb, err := getLoadbalancerWithStats(ctx, client, lbID)
+		if err != nil {
+			return nil, "", err
+		}
+		log.Printf("[DEBUG] Load balancer %s has %d active connections", lbID, lb.Stats.ActiveConnections)
+		if lb.Stats.ActiveConnections > 0 {
+			return lb, lbConnectionsDraining, nil
+		}
+
+		return lb, lbConnectionsDrained, nil
+	}
+}
+
+// getLoadbalancerWithStats gets the load balancer with its statistics.
+// Loadbalancers.Get of the SDK has no options, so the show_stats parameter is added to the request here.
+func getLoadbalancerWithStats(ctx context.Context, client *edgecloudV2.Client, lbID string) (*edgecloudV2.Loadbalancer, error) {
+	path := fmt.Sprintf("/v1/loadbalancers/%d/%d/%s", client.Project, client.Region, lbID)
+	req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = "show_stats=true"
+	lb := new(edgecloudV2.Loadbalancer)
+	if _, err := client.Do(ctx, req, lb); err != nil {
+		return nil, fmt.Errorf("cannot get load balancer %s: %w", lbID, err)
+	}
+
+	return lb, nil
+}
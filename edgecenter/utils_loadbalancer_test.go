@@ -0,0 +1,25 @@
+package edgecenter
+
+import (
+	"net"
+	"testing"
+)
+
+func TestLBMemberAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		address net.IP
+		want    string
+	}{
+		{name: "IPv4", address: net.ParseIP("192.168.0.10"), want: "192.168.0.10"},
+		{name: "IPv6", address: net.ParseIP("2001:db8::10"), want: "2001:db8::10"},
+		{name: "missing", address: nil, want: ""},
+	}
+	for _, tt := range tests {
+		if got := lbMemberAddress(tt.address); got != tt.want {
+			t.Errorf("%s: lbMemberAddress(%v) = %q, want %q", tt.name, tt.address, got, tt.want)
+		}
+	}
+}
@@ -1,30 +1,97 @@
 package edgecenter
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
 )
 
-var ErrInstancePortSecNotImplemented = fmt.Errorf("instance_port_security are not impelemented yet")
+// validatePortSecAttrs checks the mutually dependent attributes of the port security at plan time.
+func validatePortSecAttrs(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	sgsRaw, isSecGroupExists := d.GetOk(SecurityGroupsField)
+	if d.Get(PortSecurityDisabledField).(bool) && isSecGroupExists {
+		return fmt.Errorf("if attribute %q set true, you can't set %q block", PortSecurityDisabledField, SecurityGroupsField)
+	}
+
+	if !isSecGroupExists || !d.NewValueKnown(SecurityGroupsField) {
+		return nil
+	}
+	sgsList := sgsRaw.(*schema.Set).List()
+	if len(sgsList) == 0 {
+		return nil
+	}
+	sgsMap := sgsList[0].(map[string]interface{})
+	// the default policy can't be told from an unset one, so only another policy conflicts with overwrite_existing
+	policy, _ := sgsMap[ManagementPolicyField].(string)
+	if overwrite, _ := sgsMap[OverwriteExistingField].(bool); overwrite && policy == PortSecurityPolicyAdditive {
+		return fmt.Errorf("%q conflicts with %q %q, use only %q", OverwriteExistingField, ManagementPolicyField, policy, ManagementPolicyField)
+	}
+	if portSecurityPolicy(sgsMap) != PortSecurityPolicyStrict {
+		return nil
+	}
+	sgIDs, _ := sgsMap[SecurityGroupIDsField].(*schema.Set)
+	sgNames, _ := sgsMap[SecurityGroupNamesField].(*schema.Set)
+	if (sgIDs == nil || sgIDs.Len() == 0) && (sgNames == nil || sgNames.Len() == 0) {
+		return fmt.Errorf("%q %q requires %q or %q, otherwise all security groups are removed from the port",
+			ManagementPolicyField, PortSecurityPolicyStrict, SecurityGroupIDsField, SecurityGroupNamesField)
+	}
+
+	return nil
+}
+
+// portSecurityPolicy returns the management policy of the security_groups block.
+// The deprecated overwrite_existing set to true means the strict policy.
+func portSecurityPolicy(sgsMap map[string]interface{}) string {
+	if overwrite, _ := sgsMap[OverwriteExistingField].(bool); overwrite {
+		return PortSecurityPolicyStrict
+	}
+	policy, _ := sgsMap[ManagementPolicyField].(string)
+
+	return policy
+}
+
+// portSecurityStateGroupIDs returns the IDs of the security groups from the security_groups block of the state.
+// The security groups specified by name are taken by the IDs they were resolved to,
+// so they are found even if they have been renamed or deleted since.
+func portSecurityStateGroupIDs(sgsMap map[string]interface{}) *schema.Set {
+	sgIDs := schema.NewSet(schema.HashString, []interface{}{})
+	if sgIDsRaw, ok := sgsMap[SecurityGroupIDsField].(*schema.Set); ok {
+		sgIDs = sgIDs.Union(sgIDsRaw)
+	}
+	if resolvedIDsRaw, ok := sgsMap[NamedSecurityGroupIDsField].(*schema.Set); ok {
+		sgIDs = sgIDs.Union(resolvedIDsRaw)
+	}
+
+	return sgIDs
+}
+
+// portSecurityGroupIDs returns the IDs of the security groups from the security_groups block,
+// including the ones specified by name.
+func portSecurityGroupIDs(ctx context.Context, client *edgecloudV2.Client, m interface{}, sgsMap map[string]interface{}) (*schema.Set, error) {
+	sgIDs := schema.NewSet(schema.HashString, []interface{}{})
+	if sgIDsRaw, ok := sgsMap[SecurityGroupIDsField].(*schema.Set); ok {
+		sgIDs = sgIDs.Union(sgIDsRaw)
+	}
 
-func validatePortSecAttrs(d *schema.ResourceData) diag.Diagnostics {
-	diags := diag.Diagnostics{}
-	var isPortSecDisabled, isSecGroupExists bool
-	if v, ok := d.GetOk(PortSecurityDisabledField); ok {
-		isPortSecDisabled = v.(bool)
+	sgNamesRaw, ok := sgsMap[SecurityGroupNamesField].(*schema.Set)
+	if !ok || sgNamesRaw.Len() == 0 {
+		return sgIDs, nil
+	}
+
+	names := make([]string, 0, sgNamesRaw.Len())
+	for _, name := range sgNamesRaw.List() {
+		names = append(names, name.(string))
+	}
+	resolvedIDs, err := resolveSecurityGroupIDs(ctx, client, m, names)
+	if err != nil {
+		return nil, err
 	}
-	_, isSecGroupExists = d.GetOk(SecurityGroupsField)
-	if isPortSecDisabled && isSecGroupExists {
-		curDiag := diag.Diagnostic{
-			Severity:      diag.Error,
-			Summary:       fmt.Sprintf("if attribute \"%s\" set true, you can't set \"%s\" block", PortSecurityDisabledField, SecurityGroupsField),
-			Detail:        "",
-			AttributePath: nil,
-		}
-		diags = append(diags, curDiag)
+	for _, id := range resolvedIDs {
+		sgIDs.Add(id)
 	}
 
-	return diags
+	return sgIDs, nil
 }
@@ -4,6 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	edgecloud "github.com/Edge-Center/edgecentercloud-go"
 	"github.com/Edge-Center/edgecentercloud-go/edgecenter"
@@ -16,7 +20,7 @@ import (
 // ToDo Remove after migrate to Edgecenterclient-go V2.
 func findRegionByName(arr []regions.Region, name string) (int, error) {
 	for _, el := range arr {
-		if el.DisplayName == name {
+		if strings.EqualFold(el.DisplayName, name) {
 			return el.ID, nil
 		}
 	}
@@ -61,7 +65,7 @@ func GetRegion(provider *edgecloud.ProviderClient, regionID int, regionName stri
 // Returns the region ID if found, otherwise returns an error.
 func findRegionByNameV2(arr []edgecloudV2.Region, name string) (int, error) {
 	for _, el := range arr {
-		if el.DisplayName == name {
+		if strings.EqualFold(el.DisplayName, name) {
 			return el.ID, nil
 		}
 	}
@@ -91,3 +95,45 @@ func GetRegionV2(ctx context.Context, client *edgecloudV2.Client, regionID int,
 
 	return regionID, nil
 }
+
+// suppressRegionNameDiffs suppresses the diff of the region name if the names differ only in case,
+// because they refer to the same region.
+func suppressRegionNameDiffs(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(oldValue, newValue)
+}
+
+// setRegionFields stores both the ID and the name of the region of the client, whichever of them is specified.
+// The region is requested only when its name is not stored yet for the ID, e.g. on the first read or on import.
+func setRegionFields(ctx context.Context, client *edgecloudV2.Client, d *schema.ResourceData) error {
+	if d.Get(RegionIDField).(int) == client.Region && d.Get(RegionNameField).(string) != "" {
+		return nil
+	}
+
+	region, _, err := client.Regions.Get(ctx, strconv.Itoa(client.Region), nil)
+	if err != nil {
+		return fmt.Errorf("cannot get region %d: %w", client.Region, err)
+	}
+	d.Set(RegionIDField, client.Region)
+	d.Set(RegionNameField, region.DisplayName)
+
+	return nil
+}
+
+// configuredRegion returns the region ID and name to resolve the region from.
+// Both fields are computed, so the region_id stored for a region_name would win over a changed region_name;
+// when the configuration sets region_name, the stored region_id is ignored.
+// Without the configuration, e.g. on read, the stored fields are used.
+func configuredRegion(d *schema.ResourceData) (int, string) {
+	regionID, _ := d.Get(RegionIDField).(int)
+	regionName, _ := d.Get(RegionNameField).(string)
+
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(RegionNameField) {
+		return regionID, regionName
+	}
+	if rawName := config.GetAttr(RegionNameField); rawName.IsKnown() && !rawName.IsNull() {
+		return 0, regionName
+	}
+
+	return regionID, regionName
+}
@@ -0,0 +1,129 @@
+package edgecenter
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"software.sslmate.com/src/go-pkcs12"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+// SplitPKCS12 decodes the base64 encoded PKCS12 bundle and returns the certificate, the chain of the other
+// certificates and the private key in PEM format. The certificate is the one matching the private key.
+func SplitPKCS12(bundle, passphrase string) (string, string, string, error) {
+	data, err := base64.StdEncoding.DecodeString(bundle)
+	if err != nil {
+		return "", "", "", fmt.Errorf("cannot decode pkcs12 bundle from base64: %w", err)
+	}
+
+	key, leaf, caCerts, err := pkcs12.DecodeChain(data, passphrase)
+	if err != nil {
+		return "", "", "", fmt.Errorf("cannot decode pkcs12 bundle: %w", err)
+	}
+
+	var keyBlock *pem.Block
+	var publicKey crypto.PublicKey
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		keyBlock = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+		publicKey = key.Public()
+	case *ecdsa.PrivateKey:
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return "", "", "", fmt.Errorf("cannot encode private key from pkcs12 bundle: %w", err)
+		}
+		keyBlock = &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}
+		publicKey = key.Public()
+	default:
+		return "", "", "", fmt.Errorf("unsupported private key type in pkcs12 bundle")
+	}
+
+	// the leaf certificate is not necessarily the first one in the bundle
+	var certificate string
+	var chain strings.Builder
+	for _, cert := range append([]*x509.Certificate{leaf}, caCerts...) {
+		block := &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}
+		if certificate == "" && publicKeysEqual(cert.PublicKey, publicKey) {
+			certificate = string(pem.EncodeToMemory(block))
+			continue
+		}
+		chain.Write(pem.EncodeToMemory(block))
+	}
+	if certificate == "" {
+		return "", "", "", fmt.Errorf("pkcs12 bundle does not contain a certificate for the private key")
+	}
+
+	return certificate, chain.String(), string(pem.EncodeToMemory(keyBlock)), nil
+}
+
+// publicKeysEqual compares the public keys by their PKIX encoding.
+func publicKeysEqual(a, b crypto.PublicKey) bool {
+	aBytes, err := x509.MarshalPKIXPublicKey(a)
+	if err != nil {
+		return false
+	}
+	bBytes, err := x509.MarshalPKIXPublicKey(b)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(aBytes, bBytes)
+}
+
+// parseSecretTime parses the expiration or creation datetime of the secret, which is returned with or without the time zone.
+func parseSecretTime(value string) (time.Time, error) {
+	t, err := time.Parse(RFC3339WithTimeZone, value)
+	if err != nil {
+		return time.Parse(RFC3339NoZ, value)
+	}
+
+	return t, nil
+}
+
+// latestValidSecret returns the most recently created secret with the given name, which does not expire before the deadline.
+// It returns nil if there is no such secret.
+func latestValidSecret(secrets []edgecloudV2.Secret, name string, deadline time.Time) (*edgecloudV2.Secret, error) {
+	var latest *edgecloudV2.Secret
+	var latestCreated time.Time
+	for i := range secrets {
+		secret := &secrets[i]
+		if secret.Name != name {
+			continue
+		}
+		if secret.Expiration != "" {
+			expTime, err := parseSecretTime(secret.Expiration)
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse expiration of secret %s: %w", secret.ID, err)
+			}
+			if expTime.Before(deadline) {
+				continue
+			}
+		}
+		created, err := parseSecretTime(secret.Created)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse creation time of secret %s: %w", secret.ID, err)
+		}
+		if latest == nil || created.After(latestCreated) {
+			latest, latestCreated = secret, created
+		}
+	}
+
+	return latest, nil
+}
+
+func validateDuration(v interface{}, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %w", k, err)}
+	}
+
+	return nil, nil
+}
@@ -1,14 +1,48 @@
 package edgecenter
 
 import (
+	"context"
 	"crypto/md5"
 	"encoding/binary"
+	"encoding/json"
+	"fmt"
 	"io"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
 )
 
+// sgRuleICMPAny is a value of icmp_type and icmp_code which matches any ICMP type or code.
+const sgRuleICMPAny = -1
+
+// sgRuleProtocolNumbers maps IANA protocol numbers to the protocol names accepted by the API.
+var sgRuleProtocolNumbers = map[int]edgecloudV2.SecurityGroupRuleProtocol{
+	1:   edgecloudV2.SGRuleProtocolICMP,
+	2:   edgecloudV2.SGRuleProtocolIGMP,
+	6:   edgecloudV2.SGRuleProtocolTCP,
+	8:   edgecloudV2.SGRuleProtocolEGP,
+	17:  edgecloudV2.SGRuleProtocolUDP,
+	33:  edgecloudV2.SGRuleProtocolDCCP,
+	46:  edgecloudV2.SGRuleProtocolRSVP,
+	47:  edgecloudV2.SGRuleProtocolGRE,
+	50:  edgecloudV2.SGRuleProtocolESP,
+	51:  edgecloudV2.SGRuleProtocolAH,
+	89:  edgecloudV2.SGRuleProtocolOSPF,
+	112: edgecloudV2.SGRuleProtocolVRRP,
+	113: edgecloudV2.SGRuleProtocolPGM,
+	132: edgecloudV2.SGRuleProtocolSCTP,
+	136: edgecloudV2.SGRuleProtocolUDPLITE,
+}
+
+// sgMaxSuggestions is the maximum number of close matches listed when a security group name is not found.
+const sgMaxSuggestions = 3
+
 // secGroupUniqueID generates a unique ID for a security group rule using its properties.
 func secGroupUniqueID(i interface{}) int {
 	e := i.(map[string]interface{})
@@ -22,10 +56,41 @@ func secGroupUniqueID(i interface{}) int {
 	io.WriteString(h, strconv.Itoa(e["port_range_max"].(int)))
 	io.WriteString(h, e["description"].(string))
 	io.WriteString(h, e["remote_ip_prefix"].(string))
+	// icmp fields are hashed only when set, so hashes of existing rules stay the same
+	for _, field := range []string{"icmp_type", "icmp_code"} {
+		if v, ok := e[field].(int); ok && v != sgRuleICMPAny {
+			io.WriteString(h, field+strconv.Itoa(v))
+		}
+	}
 
 	return int(binary.BigEndian.Uint64(h.Sum(nil)))
 }
 
+// securityGroupRulesJSON returns a canonical JSON representation of the security group rules.
+// Server-generated fields (id, created_at, updated_at) are skipped and the rules are sorted,
+// so the result stays the same as long as the rules themselves do not change.
+func securityGroupRulesJSON(rules []interface{}) (string, error) {
+	canonical := make([]string, 0, len(rules))
+	for _, r := range rules {
+		rule := make(map[string]interface{})
+		for k, v := range r.(map[string]interface{}) {
+			switch k {
+			case "id", "created_at", "updated_at":
+				continue
+			}
+			rule[k] = v
+		}
+		b, err := json.Marshal(rule)
+		if err != nil {
+			return "", err
+		}
+		canonical = append(canonical, string(b))
+	}
+	sort.Strings(canonical)
+
+	return "[" + strings.Join(canonical, ",") + "]", nil
+}
+
 // extractSecurityGroupRuleCreateRequestV2 creates a security group rule from the provided map and security group ID.
 func extractSecurityGroupRuleCreateRequestV2(r interface{}, gid string) edgecloudV2.RuleCreateRequest {
 	rule := r.(map[string]interface{})
@@ -42,6 +107,10 @@ func extractSecurityGroupRuleCreateRequestV2(r interface{}, gid string) edgeclou
 		opts.PortRangeMin = &minP
 		opts.PortRangeMax = &maxP
 	}
+	if icmpType, icmpCode, ok := securityGroupRuleICMP(rule); ok {
+		opts.PortRangeMin = &icmpType
+		opts.PortRangeMax = icmpCode
+	}
 
 	description, _ := rule["description"].(string)
 	opts.Description = &description
@@ -54,6 +123,59 @@ func extractSecurityGroupRuleCreateRequestV2(r interface{}, gid string) edgeclou
 	return opts
 }
 
+// copySecurityGroupRules returns the rules of the security group to be created in another one.
+// The rules referencing the security group itself are returned separately without the remote group,
+// since they must reference the new security group, which is known only after it is created.
+func copySecurityGroupRules(ctx context.Context, client *edgecloudV2.Client, sgID string) ([]edgecloudV2.RuleCreateRequest, []edgecloudV2.RuleCreateRequest, error) {
+	sg, _, err := client.SecurityGroups.Get(ctx, sgID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot get security group %s to copy rules from: %w", sgID, err)
+	}
+
+	rules := make([]edgecloudV2.RuleCreateRequest, 0, len(sg.SecurityGroupRules))
+	var selfRules []edgecloudV2.RuleCreateRequest
+	for _, sgr := range sg.SecurityGroupRules {
+		rule := edgecloudV2.RuleCreateRequest{
+			Direction:      sgr.Direction,
+			Protocol:       edgecloudV2.SGRuleProtocolANY,
+			PortRangeMin:   sgr.PortRangeMin,
+			PortRangeMax:   sgr.PortRangeMax,
+			Description:    sgr.Description,
+			RemoteIPPrefix: sgr.RemoteIPPrefix,
+		}
+		if sgr.EtherType != nil {
+			rule.EtherType = *sgr.EtherType
+		}
+		if sgr.Protocol != nil {
+			rule.Protocol = *sgr.Protocol
+		}
+		if sgr.RemoteGroupID == sgID {
+			selfRules = append(selfRules, rule)
+			continue
+		}
+		if sgr.RemoteGroupID != "" {
+			remoteGroupID := sgr.RemoteGroupID
+			rule.RemoteGroupID = &remoteGroupID
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, selfRules, nil
+}
+
+// createSelfReferencingSecurityGroupRules creates the rules in the security group with the group itself as the remote group.
+func createSelfReferencingSecurityGroupRules(ctx context.Context, client *edgecloudV2.Client, sgID string, rules []edgecloudV2.RuleCreateRequest) error {
+	for _, rule := range rules {
+		rule.SecurityGroupID = &sgID
+		rule.RemoteGroupID = &sgID
+		if _, _, err := client.SecurityGroups.RuleCreate(ctx, sgID, &rule); err != nil {
+			return fmt.Errorf("cannot create the copied rule referencing security group %s: %w", sgID, err)
+		}
+	}
+
+	return nil
+}
+
 // extractSecurityGroupRuleUpdateRequestV2 creates a security group rule from the provided map and security group ID.
 func extractSecurityGroupRuleUpdateRequestV2(r interface{}, gid string) edgecloudV2.RuleUpdateRequest {
 	rule := r.(map[string]interface{})
@@ -70,6 +192,13 @@ func extractSecurityGroupRuleUpdateRequestV2(r interface{}, gid string) edgeclou
 		opts.PortRangeMin = minP
 		opts.PortRangeMax = maxP
 	}
+	if icmpType, icmpCode, ok := securityGroupRuleICMP(rule); ok {
+		opts.PortRangeMin = icmpType
+		opts.PortRangeMax = 0
+		if icmpCode != nil {
+			opts.PortRangeMax = *icmpCode
+		}
+	}
 
 	description, _ := rule["description"].(string)
 	opts.Description = description
@@ -81,3 +210,341 @@ func extractSecurityGroupRuleUpdateRequestV2(r interface{}, gid string) edgeclou
 
 	return opts
 }
+
+// securityGroupRuleICMP returns ICMP type and code of the icmp rule. The API keeps them
+// in port_range_min and port_range_max fields of the rule, nil code means any code.
+func securityGroupRuleICMP(rule map[string]interface{}) (int, *int, bool) {
+	if rule["protocol"].(string) != edgecloudV2.SGRuleProtocolICMP.String() {
+		return 0, nil, false
+	}
+	icmpType, _ := rule["icmp_type"].(int)
+	if icmpType == sgRuleICMPAny {
+		return 0, nil, false
+	}
+	icmpCode, _ := rule["icmp_code"].(int)
+	if icmpCode == sgRuleICMPAny {
+		return icmpType, nil, true
+	}
+
+	return icmpType, &icmpCode, true
+}
+
+// flattenSecurityGroupRule returns the rule in the form of the security_group_rules field.
+func flattenSecurityGroupRule(sgr edgecloudV2.SecurityGroupRule) map[string]interface{} {
+	r := make(map[string]interface{})
+	r["id"] = sgr.ID
+	r["direction"] = sgr.Direction.String()
+
+	if sgr.EtherType != nil {
+		r["ethertype"] = sgr.EtherType.String()
+	}
+
+	r["protocol"] = edgecloudV2.SGRuleProtocolANY
+	if sgr.Protocol != nil {
+		r["protocol"] = sgr.Protocol.String()
+	}
+
+	r["port_range_max"] = 65535
+	if sgr.PortRangeMax != nil {
+		r["port_range_max"] = *sgr.PortRangeMax
+	}
+	r["port_range_min"] = 1
+	if sgr.PortRangeMin != nil {
+		r["port_range_min"] = *sgr.PortRangeMin
+	}
+
+	r["icmp_type"] = sgRuleICMPAny
+	r["icmp_code"] = sgRuleICMPAny
+	// the API keeps ICMP type and code in the port range fields
+	isICMPRange := sgr.PortRangeMin != nil && *sgr.PortRangeMin <= 255 && (sgr.PortRangeMax == nil || *sgr.PortRangeMax <= 255)
+	if r["protocol"] == edgecloudV2.SGRuleProtocolICMP.String() && isICMPRange {
+		r["icmp_type"] = *sgr.PortRangeMin
+		if sgr.PortRangeMax != nil {
+			r["icmp_code"] = *sgr.PortRangeMax
+		}
+		r["port_range_min"] = 1
+		r["port_range_max"] = 65535
+	}
+
+	r["description"] = ""
+	if sgr.Description != nil {
+		r["description"] = *sgr.Description
+	}
+
+	r["remote_ip_prefix"] = ""
+	if sgr.RemoteIPPrefix != nil {
+		r["remote_ip_prefix"] = *sgr.RemoteIPPrefix
+	}
+
+	r["updated_at"] = sgr.UpdatedAt
+	r["created_at"] = sgr.CreatedAt
+
+	return r
+}
+
+// normalizeSecurityGroupRuleICMP returns the rule in the form it is read from the API. The API keeps ICMP type and code
+// in the port range fields, so an icmp rule with a port range within the ICMP values is read with icmp_type and icmp_code.
+func normalizeSecurityGroupRuleICMP(rule map[string]interface{}) map[string]interface{} {
+	if rule["protocol"] != edgecloudV2.SGRuleProtocolICMP.String() || rule["icmp_type"] != sgRuleICMPAny {
+		return rule
+	}
+	portMin, _ := rule["port_range_min"].(int)
+	portMax, _ := rule["port_range_max"].(int)
+	if portMin > 255 || portMax > 255 {
+		return rule
+	}
+
+	normalized := make(map[string]interface{}, len(rule))
+	for k, v := range rule {
+		normalized[k] = v
+	}
+	normalized["icmp_type"] = portMin
+	normalized["icmp_code"] = portMax
+	normalized["port_range_min"] = 1
+	normalized["port_range_max"] = 65535
+
+	return normalized
+}
+
+// keepConfiguredSecurityGroupRuleForm sets the ICMP and port range fields of the rules read from the API
+// as they are in the same rules of the prior state, so that an icmp rule configured with the port range fields
+// is not read with icmp_type and icmp_code, which would show a diff on every plan.
+func keepConfiguredSecurityGroupRuleForm(rules []interface{}, prior []interface{}) {
+	priorRules := make(map[int]map[string]interface{}, len(prior))
+	for _, p := range prior {
+		rule := p.(map[string]interface{})
+		priorRules[secGroupUniqueID(normalizeSecurityGroupRuleICMP(rule))] = rule
+	}
+
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		priorRule, ok := priorRules[secGroupUniqueID(normalizeSecurityGroupRuleICMP(rule))]
+		if !ok {
+			continue
+		}
+		for _, field := range []string{"icmp_type", "icmp_code", "port_range_min", "port_range_max"} {
+			rule[field] = priorRule[field]
+		}
+	}
+}
+
+// validateSecurityGroupRuleProtocol checks the protocol name and gives a hint
+// with the protocol name if the protocol number is used.
+func validateSecurityGroupRuleProtocol(v interface{}, _ cty.Path) diag.Diagnostics {
+	val := v.(string)
+	if edgecloudV2.SecurityGroupRuleProtocol(val).IsValid() == nil {
+		return nil
+	}
+	if number, err := strconv.Atoi(val); err == nil {
+		if name, ok := sgRuleProtocolNumbers[number]; ok {
+			return diag.Errorf("protocol number %d is not supported, use its name '%s' instead", number, name)
+		}
+		return diag.Errorf("protocol number %d is not supported, available value is %s", number,
+			strings.Join(edgecloudV2.SecurityGroupRuleProtocol("").StringList(), ","))
+	}
+
+	return diag.Errorf("wrong protocol '%s', available value is %s", val,
+		strings.Join(edgecloudV2.SecurityGroupRuleProtocol("").StringList(), ","))
+}
+
+// securityGroupNameCache keeps the IDs of the security groups by name for each project and region
+// during a provider run, so the resources referring to security groups by name share a single lookup.
+// The security groups created, renamed or deleted by the provider drop the names of their project and region,
+// see invalidateSecurityGroupNames, and a name which is not found refreshes them once.
+type securityGroupNameCache struct {
+	mu  sync.Mutex
+	ids map[string]map[string][]string
+}
+
+func newSecurityGroupNameCache() *securityGroupNameCache {
+	return &securityGroupNameCache{ids: make(map[string]map[string][]string)}
+}
+
+func securityGroupNameCacheKey(client *edgecloudV2.Client) string {
+	return fmt.Sprintf("%d:%d", client.Project, client.Region)
+}
+
+func (c *securityGroupNameCache) get(key string) (map[string][]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	byName, ok := c.ids[key]
+
+	return byName, ok
+}
+
+func (c *securityGroupNameCache) set(key string, byName map[string][]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ids[key] = byName
+}
+
+func (c *securityGroupNameCache) invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.ids, key)
+}
+
+// securityGroupNameCacheOf returns the cache of the provider configuration, nil if there is none.
+func securityGroupNameCacheOf(m interface{}) *securityGroupNameCache {
+	if config, ok := m.(*Config); ok {
+		return config.SecurityGroupNames
+	}
+
+	return nil
+}
+
+// invalidateSecurityGroupNames drops the cached names of the project and region of the client,
+// it must be called when the provider creates, renames or deletes a security group.
+func invalidateSecurityGroupNames(m interface{}, client *edgecloudV2.Client) {
+	if cache := securityGroupNameCacheOf(m); cache != nil {
+		cache.invalidate(securityGroupNameCacheKey(client))
+	}
+}
+
+// listSecurityGroupNames returns the IDs of the security groups of the project and region by name.
+// The cached names are returned unless refresh is set.
+func listSecurityGroupNames(ctx context.Context, client *edgecloudV2.Client, m interface{}, refresh bool) (map[string][]string, error) {
+	cache := securityGroupNameCacheOf(m)
+	key := securityGroupNameCacheKey(client)
+	if cache != nil && !refresh {
+		if byName, ok := cache.get(key); ok {
+			return byName, nil
+		}
+	}
+
+	sgs, _, err := client.SecurityGroups.List(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get security groups. Error: %w", err)
+	}
+	byName := make(map[string][]string, len(sgs))
+	for _, sg := range sgs {
+		byName[sg.Name] = append(byName[sg.Name], sg.ID)
+	}
+	if cache != nil {
+		cache.set(key, byName)
+	}
+
+	return byName, nil
+}
+
+// resolveSecurityGroupIDs returns the IDs of the security groups with the given names, which are looked up
+// with the name_prefix of the provider first, see prefixedNames.
+// The names are looked up in the cache of the provider first, which is refreshed once if any name is missing.
+func resolveSecurityGroupIDs(ctx context.Context, client *edgecloudV2.Client, m interface{}, names []string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	byName, err := listSecurityGroupNames(ctx, client, m, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if !securityGroupNameFound(m, name, byName) {
+			if byName, err = listSecurityGroupNames(ctx, client, m, true); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		var sgIDs []string
+		for _, sgName := range prefixedNames(m, name) {
+			if sgIDs = byName[sgName]; len(sgIDs) > 0 {
+				break
+			}
+		}
+		switch len(sgIDs) {
+		case 0:
+			suggestions := securityGroupNameSuggestions(name, byName)
+			if len(suggestions) == 0 {
+				return nil, fmt.Errorf("security group with name %s not found", name)
+			}
+			return nil, fmt.Errorf("security group with name %s not found, did you mean: %s?", name, strings.Join(suggestions, ", "))
+		case 1:
+			ids = append(ids, sgIDs[0])
+		default:
+			return nil, fmt.Errorf("there are %d security groups with name %s, use security group IDs instead", len(sgIDs), name)
+		}
+	}
+
+	return ids, nil
+}
+
+// securityGroupNameFound checks whether there is a security group with the name, see prefixedNames.
+func securityGroupNameFound(m interface{}, name string, byName map[string][]string) bool {
+	for _, sgName := range prefixedNames(m, name) {
+		if len(byName[sgName]) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// securityGroupNameSuggestions returns the names which are close to the given one,
+// e.g. differ only in case or by a few characters, the closest names go first.
+func securityGroupNameSuggestions(name string, byName map[string][]string) []string {
+	type match struct {
+		name     string
+		distance int
+	}
+
+	maxDistance := len(name) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	var matches []match
+	lowerName := strings.ToLower(name)
+	for candidate := range byName {
+		lowerCandidate := strings.ToLower(candidate)
+		distance := levenshteinDistance(lowerName, lowerCandidate)
+		if distance <= maxDistance || strings.Contains(lowerCandidate, lowerName) {
+			matches = append(matches, match{name: candidate, distance: distance})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].name < matches[j].name
+	})
+
+	suggestions := make([]string, 0, sgMaxSuggestions)
+	for i := 0; i < len(matches) && i < sgMaxSuggestions; i++ {
+		suggestions = append(suggestions, matches[i].name)
+	}
+
+	return suggestions
+}
+
+// levenshteinDistance returns the number of single-character edits required to change a into b.
+func levenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
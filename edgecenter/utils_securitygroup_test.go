@@ -0,0 +1,157 @@
+package edgecenter
+
+import (
+	"testing"
+
+	edgecloudV2 "github.com/Edge-Center/edgecentercloud-go/v2"
+)
+
+func testICMPRule(icmpType, icmpCode, portMin, portMax int) map[string]interface{} {
+	return map[string]interface{}{
+		"direction":        edgecloudV2.SGRuleDirectionIngress.String(),
+		"ethertype":        edgecloudV2.EtherTypeIPv4.String(),
+		"protocol":         edgecloudV2.SGRuleProtocolICMP.String(),
+		"icmp_type":        icmpType,
+		"icmp_code":        icmpCode,
+		"port_range_min":   portMin,
+		"port_range_max":   portMax,
+		"description":      "",
+		"remote_ip_prefix": "0.0.0.0/0",
+	}
+}
+
+func TestNormalizeSecurityGroupRuleICMP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		rule map[string]interface{}
+		want map[string]interface{}
+	}{
+		{
+			name: "port range within ICMP values",
+			rule: testICMPRule(sgRuleICMPAny, sgRuleICMPAny, 3, 1),
+			want: testICMPRule(3, 1, 1, 65535),
+		},
+		{
+			name: "icmp_type set",
+			rule: testICMPRule(8, sgRuleICMPAny, 1, 65535),
+			want: testICMPRule(8, sgRuleICMPAny, 1, 65535),
+		},
+		{
+			name: "default port range",
+			rule: testICMPRule(sgRuleICMPAny, sgRuleICMPAny, 1, 65535),
+			want: testICMPRule(sgRuleICMPAny, sgRuleICMPAny, 1, 65535),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := normalizeSecurityGroupRuleICMP(tt.rule)
+			if secGroupUniqueID(got) != secGroupUniqueID(tt.want) {
+				t.Errorf("normalizeSecurityGroupRuleICMP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSecurityGroupICMPRulePlanStability checks that an icmp rule read back from the API
+// has the same form as in the configuration, so that the next plan is empty.
+func TestSecurityGroupICMPRulePlanStability(t *testing.T) {
+	t.Parallel()
+
+	direction := edgecloudV2.SGRuleDirectionIngress
+	etherType := edgecloudV2.EtherTypeIPv4
+	protocol := edgecloudV2.SGRuleProtocolICMP
+	remoteIPPrefix := "0.0.0.0/0"
+	description := ""
+	// the API keeps ICMP type and code in the port range fields
+	apiRule := func(portMin int, portMax *int) edgecloudV2.SecurityGroupRule {
+		return edgecloudV2.SecurityGroupRule{
+			Direction:      direction,
+			EtherType:      &etherType,
+			Protocol:       &protocol,
+			PortRangeMin:   &portMin,
+			PortRangeMax:   portMax,
+			RemoteIPPrefix: &remoteIPPrefix,
+			Description:    &description,
+		}
+	}
+	icmpCode := 1
+
+	tests := []struct {
+		name       string
+		configured map[string]interface{}
+		read       edgecloudV2.SecurityGroupRule
+	}{
+		{
+			name:       "configured with the port range fields",
+			configured: testICMPRule(sgRuleICMPAny, sgRuleICMPAny, 3, 1),
+			read:       apiRule(3, &icmpCode),
+		},
+		{
+			name:       "configured with icmp_type and icmp_code",
+			configured: testICMPRule(3, 1, 1, 65535),
+			read:       apiRule(3, &icmpCode),
+		},
+		{
+			name:       "configured with icmp_type only",
+			configured: testICMPRule(3, sgRuleICMPAny, 1, 65535),
+			read:       apiRule(3, nil),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rules := []interface{}{flattenSecurityGroupRule(tt.read)}
+			keepConfiguredSecurityGroupRuleForm(rules, []interface{}{tt.configured})
+
+			read := rules[0].(map[string]interface{})
+			for _, field := range []string{"icmp_type", "icmp_code", "port_range_min", "port_range_max"} {
+				if read[field] != tt.configured[field] {
+					t.Errorf("%s = %v, want %v", field, read[field], tt.configured[field])
+				}
+			}
+			// the rules are a set, the same hash means no diff
+			if secGroupUniqueID(read) != secGroupUniqueID(tt.configured) {
+				t.Errorf("the rule read from the API %v differs from the configured rule %v", read, tt.configured)
+			}
+		})
+	}
+}
+
+func TestKeepConfiguredSecurityGroupRuleFormOnImport(t *testing.T) {
+	t.Parallel()
+
+	rule := testICMPRule(3, 1, 1, 65535)
+	keepConfiguredSecurityGroupRuleForm([]interface{}{rule}, nil)
+
+	if rule["icmp_type"] != 3 || rule["icmp_code"] != 1 {
+		t.Errorf("rule without prior state = %v, want it read with icmp_type and icmp_code", rule)
+	}
+}
+
+func TestSecurityGroupNameCache(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{SecurityGroupNames: newSecurityGroupNameCache()}
+	client := &edgecloudV2.Client{Project: 1, Region: 2}
+	other := &edgecloudV2.Client{Project: 1, Region: 3}
+	config.SecurityGroupNames.set(securityGroupNameCacheKey(client), map[string][]string{"web": {"id-web"}})
+	config.SecurityGroupNames.set(securityGroupNameCacheKey(other), map[string][]string{"db": {"id-db"}})
+
+	byName, ok := config.SecurityGroupNames.get(securityGroupNameCacheKey(client))
+	if !ok || !securityGroupNameFound(config, "web", byName) {
+		t.Fatalf("cached names = %v, want web", byName)
+	}
+
+	invalidateSecurityGroupNames(config, client)
+	if _, ok := config.SecurityGroupNames.get(securityGroupNameCacheKey(client)); ok {
+		t.Error("the names of the project and region are cached after invalidation")
+	}
+	if _, ok := config.SecurityGroupNames.get(securityGroupNameCacheKey(other)); !ok {
+		t.Error("the names of another region are dropped by invalidation")
+	}
+}
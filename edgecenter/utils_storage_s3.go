@@ -0,0 +1,159 @@
+package edgecenter
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	storageS3RequestTimeout = 60 * time.Second
+	// storageS3DefaultRegion is the default region of the S3 clients, used for the storage without location.
+	storageS3DefaultRegion = "us-east-1"
+	storageS3SigningAlgo   = "AWS4-HMAC-SHA256"
+)
+
+// storageS3Client sends the requests to the S3 API of the storage, which the storage API doesn't cover,
+// signed by the S3 credentials of the storage.
+type storageS3Client struct {
+	address   string
+	region    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+}
+
+func newStorageS3Client(address, region, accessKey, secretKey string) *storageS3Client {
+	return &storageS3Client{
+		address:   address,
+		region:    region,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		client:    &http.Client{Timeout: storageS3RequestTimeout},
+	}
+}
+
+// Do sends the request to the object of the bucket. The returned response must be closed by the caller.
+func (c *storageS3Client) Do(ctx context.Context, method, bucket, key string, body []byte, headers map[string]string) (*http.Response, error) {
+	path := storageS3ObjectPath(bucket, key)
+	req, err := http.NewRequestWithContext(ctx, method, "https://"+c.address+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range headers {
+		if value != "" {
+			req.Header.Set(name, value)
+		}
+	}
+	signStorageS3Request(req, path, body, c.region, c.accessKey, c.secretKey, time.Now().UTC())
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return resp, fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	return resp, nil
+}
+
+// storageS3SigningRegion returns the region of the signature, which is the location of the storage.
+func storageS3SigningRegion(location string) string {
+	if location == "" {
+		return storageS3DefaultRegion
+	}
+
+	return location
+}
+
+// storageS3ObjectPath returns the escaped path of the object of the bucket, the slashes of the key are kept.
+func storageS3ObjectPath(bucket, key string) string {
+	return "/" + storageS3Escape(bucket) + "/" + strings.ReplaceAll(storageS3Escape(key), "%2F", "/")
+}
+
+// signStorageS3Request adds the AWS Signature Version 4 of the host, date and payload hash headers to the request.
+// The path must be escaped already, e.g. by storageS3ObjectPath.
+func signStorageS3Request(req *http.Request, path string, body []byte, region, accessKey, secretKey string, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		storageS3CanonicalQuery(req.URL.Query()),
+		"host:" + req.URL.Host,
+		"x-amz-content-sha256:" + payloadHash,
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, region, "s3", "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{storageS3SigningAlgo, amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := []byte("AWS4" + secretKey)
+	for _, part := range []string{date, region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		storageS3SigningAlgo, accessKey, scope, signedHeaders, signature))
+}
+
+// storageS3CanonicalQuery returns the query parameters sorted by name and escaped, as the signature requires.
+func storageS3CanonicalQuery(query url.Values) string {
+	params := make([]string, 0, len(query))
+	for name, values := range query {
+		for _, value := range values {
+			params = append(params, storageS3Escape(name)+"="+storageS3Escape(value))
+		}
+	}
+	sort.Strings(params)
+
+	return strings.Join(params, "&")
+}
+
+// storageS3Escape encodes every byte of the string except the unreserved characters, as the signature requires.
+func storageS3Escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,68 @@
+package edgecenter
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const waitForResourceStatusMinTimeout = 3 * time.Second
+
+// ResourceStatusFetchFunc fetches the resource and its current status, e.g. the vm_state of an instance
+// or the provisioning status of a load balancer.
+type ResourceStatusFetchFunc[T any, S ~string] func() (T, S, error)
+
+// WaitForResourceStatus polls the resource until its status is one of the target ones and returns the last fetched resource.
+// If pending is not empty, any status outside of pending and target is an error, otherwise any status is waited out.
+// The first poll is made after delay, so that the status of the previous operation is not read as the target one.
+// Every poll is reported to the terraform log. On timeout the returned error is a *retry.TimeoutError.
+func WaitForResourceStatus[T any, S ~string](ctx context.Context, fetch ResourceStatusFetchFunc[T, S], target, pending []S, timeout, delay time.Duration) (T, error) {
+	start := time.Now()
+	var lastStatus S
+	stateConf := &retry.StateChangeConf{
+		Pending: statusStrings(pending),
+		Target:  statusStrings(target),
+		Refresh: func() (interface{}, string, error) {
+			resource, status, err := fetch()
+			if err != nil {
+				return nil, "", err
+			}
+			fields := map[string]interface{}{
+				"status":  string(status),
+				"target":  statusStrings(target),
+				"elapsed": time.Since(start).Round(time.Second).String(),
+				"timeout": timeout.String(),
+			}
+			if status != lastStatus {
+				tflog.Info(ctx, "Resource status changed", fields)
+				lastStatus = status
+			} else {
+				tflog.Debug(ctx, "Waiting for resource status", fields)
+			}
+
+			return resource, string(status), nil
+		},
+		Timeout:    timeout,
+		Delay:      delay,
+		MinTimeout: waitForResourceStatusMinTimeout,
+	}
+
+	var resource T
+	result, err := stateConf.WaitForStateContext(ctx)
+	if result != nil {
+		resource = result.(T)
+	}
+
+	return resource, err
+}
+
+func statusStrings[S ~string](statuses []S) []string {
+	result := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		result = append(result, string(status))
+	}
+
+	return result
+}
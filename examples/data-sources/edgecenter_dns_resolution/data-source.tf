@@ -0,0 +1,16 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+data "edgecenter_dns_resolution" "www" {
+  zone   = edgecenter_dns_zone_record.www.zone
+  domain = edgecenter_dns_zone_record.www.domain
+  type   = edgecenter_dns_zone_record.www.type
+}
+
+check "www_resolves" {
+  assert {
+    condition     = contains(data.edgecenter_dns_resolution.www.values, "127.0.0.1")
+    error_message = "www.example.com is not served by the nameservers yet"
+  }
+}
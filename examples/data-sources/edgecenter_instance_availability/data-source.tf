@@ -0,0 +1,18 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+data "edgecenter_instance_availability" "preferred" {
+  project_id     = 1
+  region_id      = 1
+  flavor_id      = "g1-standard-2-4"
+  instance_count = 3
+}
+
+locals {
+  flavor_id = data.edgecenter_instance_availability.preferred.available ? "g1-standard-2-4" : "g1-standard-4-8"
+}
+
+output "unavailability_reason" {
+  value = data.edgecenter_instance_availability.preferred.reason
+}
@@ -0,0 +1,34 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+data "edgecenter_project" "pr" {
+  name = "test"
+}
+
+data "edgecenter_region" "rg" {
+  name = "ED-10 Preprod"
+}
+
+data "edgecenter_instances" "web" {
+  region_id  = data.edgecenter_region.rg.id
+  project_id = data.edgecenter_project.pr.id
+
+  name_regex = "^web-"
+  status     = "ACTIVE"
+  metadata = {
+    role = "web"
+  }
+}
+
+resource "edgecenter_lbmember" "web" {
+  for_each = { for instance in data.edgecenter_instances.web.instances : instance.name => instance }
+
+  region_id  = data.edgecenter_region.rg.id
+  project_id = data.edgecenter_project.pr.id
+
+  pool_id       = "9a5a0c8d-1e73-46cb-9e7f-6f8f5d2b9b55"
+  instance_id   = each.value.id
+  address       = each.value.addresses[0].address
+  protocol_port = 8080
+}
@@ -0,0 +1,12 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+data "edgecenter_k8s_versions" "available" {
+  project_id = 1
+  region_id  = 1
+}
+
+output "k8s_version_available" {
+  value = contains(data.edgecenter_k8s_versions.available.versions, "1.28.3")
+}
@@ -0,0 +1,17 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+data "edgecenter_lbflavor" "lb" {
+  project_id = 1
+  region_id  = 1
+  min_vcpus  = 2
+  min_ram    = 2048
+}
+
+resource "edgecenter_loadbalancerv2" "lb" {
+  project_id = 1
+  region_id  = 1
+  name       = "test"
+  flavor     = data.edgecenter_lbflavor.lb.flavor_name
+}
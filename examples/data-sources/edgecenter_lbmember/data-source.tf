@@ -0,0 +1,27 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+data "edgecenter_project" "pr" {
+  name = "test"
+}
+
+data "edgecenter_region" "rg" {
+  name = "ED-10 Preprod"
+}
+
+data "edgecenter_lbpool" "pool" {
+  name       = "test-pool"
+  region_id  = data.edgecenter_region.rg.id
+  project_id = data.edgecenter_project.pr.id
+}
+
+data "edgecenter_lbmember" "members" {
+  pool_id    = data.edgecenter_lbpool.pool.id
+  region_id  = data.edgecenter_region.rg.id
+  project_id = data.edgecenter_project.pr.id
+}
+
+output "unhealthy_members" {
+  value = [for m in data.edgecenter_lbmember.members.members : m.address if m.operating_status != "ONLINE"]
+}
@@ -0,0 +1,28 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+data "edgecenter_project" "pr" {
+  name = "test"
+}
+
+data "edgecenter_region" "rg" {
+  name = "ED-10 Preprod"
+}
+
+data "edgecenter_port" "port" {
+  region_id  = data.edgecenter_region.rg.id
+  project_id = data.edgecenter_project.pr.id
+  ip_address = "192.168.42.10"
+  network_id = "e52cd4a8-0ef8-4b5d-8c65-6c18e2b9a8c1"
+}
+
+resource "edgecenter_instance_port_security" "port_security" {
+  region_id   = data.edgecenter_region.rg.id
+  project_id  = data.edgecenter_project.pr.id
+  port_id     = data.edgecenter_port.port.id
+  instance_id = data.edgecenter_port.port.instance_id
+  security_groups {
+    security_group_ids = ["cd114905-1bc7-45d7-9def-463f16379563"]
+  }
+}
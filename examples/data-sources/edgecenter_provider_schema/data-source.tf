@@ -0,0 +1,9 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+data "edgecenter_provider_schema" "schema" {}
+
+output "importable_resources" {
+  value = [for r in data.edgecenter_provider_schema.schema.resources : r.name if r.importable]
+}
@@ -16,6 +16,12 @@ data "edgecenter_router" "tr" {
   project_id = data.edgecenter_project.pr.id
 }
 
+data "edgecenter_router" "by_network" {
+  network_id = "e7944e55-f957-413d-aa56-fdc876543113"
+  region_id  = data.edgecenter_region.rg.id
+  project_id = data.edgecenter_project.pr.id
+}
+
 output "view" {
   value = data.edgecenter_router.tr
 }
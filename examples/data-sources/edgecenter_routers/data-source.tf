@@ -0,0 +1,22 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+data "edgecenter_project" "pr" {
+  name = "test"
+}
+
+data "edgecenter_region" "rg" {
+  name = "ED-10 Preprod"
+}
+
+data "edgecenter_routers" "prod" {
+  name_regex = "^prod-"
+  network_id = "e7944e55-f957-413d-aa56-fdc876543113"
+  region_id  = data.edgecenter_region.rg.id
+  project_id = data.edgecenter_project.pr.id
+}
+
+output "routes" {
+  value = { for r in data.edgecenter_routers.prod.routers : r.name => r.routes }
+}
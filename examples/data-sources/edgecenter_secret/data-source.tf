@@ -16,6 +16,14 @@ data "edgecenter_secret" "lb_https" {
   project_id = data.edgecenter_project.pr.id
 }
 
+// the newest certificate which is valid for at least 30 days, e.g. after the rotation
+data "edgecenter_secret" "lb_https_valid" {
+  name                   = "lb_https"
+  must_not_expire_within = "720h"
+  region_id              = data.edgecenter_region.rg.id
+  project_id             = data.edgecenter_project.pr.id
+}
+
 output "view" {
   value = data.edgecenter_secret.lb_https
 }
@@ -45,3 +45,9 @@ resource "edgecenter_cdn_resource" "cdn_example_com" {
     }
   }
 }
+
+resource "edgecenter_cdn_resource" "cdn_storage_example_com" {
+  cname             = "static.example.com"
+  origin_storage_id = edgecenter_storage_s3.storage.id
+  origin_protocol   = "HTTPS"
+}
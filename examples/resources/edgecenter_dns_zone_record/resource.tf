@@ -97,3 +97,20 @@ resource "edgecenter_dns_zone_record" "subdomain_examplezone_caa" {
     enabled = true
   }
 }
+
+//
+// example1: zone apex pointing to a load balancer, the record follows the VIP address of the load balancer
+//
+resource "edgecenter_dns_zone_record" "apex_loadbalancer" {
+  zone   = edgecenter_dns_zone.examplezone0.name
+  domain = edgecenter_dns_zone.examplezone0.name
+  type   = "A"
+  ttl    = 60
+
+  meta {
+  }
+
+  resource_record {
+    content = edgecenter_loadbalancerv2.lb.vip_address
+  }
+}
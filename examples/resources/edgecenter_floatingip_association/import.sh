@@ -0,0 +1,2 @@
+# import using <project_id>:<region_id>:<floating_ip_id>:<port_id> format
+terraform import edgecenter_floatingip_association.floating_ip_association 1:6:a775dd94-4e9c-4da7-9f0e-ffc9ae34446b:5c992875-f653-4b7b-af5b-1dc3019e5ffa
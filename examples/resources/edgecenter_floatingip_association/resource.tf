@@ -0,0 +1,19 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+resource "edgecenter_floatingip" "floating_ip" {
+  project_id = 1
+  region_id  = 1
+
+  lifecycle {
+    ignore_changes = [port_id, fixed_ip_address]
+  }
+}
+
+resource "edgecenter_floatingip_association" "floating_ip_association" {
+  project_id     = 1
+  region_id      = 1
+  floating_ip_id = edgecenter_floatingip.floating_ip.id
+  port_id        = "5c992875-f653-4b7b-af5b-1dc3019e5ffa" // instance`s interface port_id
+}
@@ -0,0 +1,18 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+resource "edgecenter_image" "image" {
+  project_id      = 1
+  region_id       = 1
+  name            = "ubuntu-22.04"
+  url             = "https://cloud-images.ubuntu.com/jammy/current/jammy-server-cloudimg-amd64.img"
+  os_distro       = "ubuntu"
+  os_version      = "22.04"
+  os_type         = "linux"
+  hw_machine_type = "q35"
+  ssh_key         = "allow"
+  metadata_map = {
+    env = "test"
+  }
+}
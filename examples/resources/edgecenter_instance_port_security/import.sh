@@ -0,0 +1,2 @@
+# import using <project_id>:<region_id>:<instance_id>:<port_id> format
+terraform import edgecenter_instance_port_security.port_security_1 1:6:a775dd94-4e9c-4da7-9f0e-ffc9ae34446b:447d2959-8ae0-4ca0-8d47-9f050a3637d7
@@ -9,7 +9,7 @@ resource "edgecenter_instance_port_security" "port_security" {
   project_id             = 1
   port_security_disabled = false
   security_groups {
-    overwrite_existing = true
+    management_policy  = "strict"
     security_group_ids = ["cd114905-1bc7-45d7-9def-463f16379563", "4c2fb2a4-8535-474e-aa7f-ac35804de389"]
   }
 }
@@ -43,4 +43,12 @@ resource "edgecenter_lbmember" "lbm" {
   weight        = 5
 }
 
-
+resource "edgecenter_lbmember" "lbm_monitored" {
+  project_id      = 1
+  region_id       = 1
+  pool_id         = edgecenter_lbpool.pl.id
+  address         = "10.10.2.16"
+  protocol_port   = 8081
+  monitor_address = "10.10.3.16"
+  monitor_port    = 9000
+}
@@ -0,0 +1,20 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+// Tears down the preview environment of a pull request, e.g. from a CI job.
+resource "edgecenter_project_purge" "preview" {
+  project_id = 1
+  region_id  = 1
+
+  resource_types = ["instances", "loadbalancers", "floating_ips", "volumes"]
+  dry_run        = true
+
+  triggers = {
+    pull_request = var.pull_request
+  }
+}
+
+output "purged_resources" {
+  value = edgecenter_project_purge.preview.resources
+}
@@ -0,0 +1,2 @@
+# import using <client_id> format
+terraform import edgecenter_quota_notification.quota_notification 12345
\ No newline at end of file
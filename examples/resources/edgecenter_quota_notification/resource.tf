@@ -0,0 +1,12 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+data "edgecenter_project" "pr" {
+  name = "test"
+}
+
+resource "edgecenter_quota_notification" "quota_notification" {
+  client_id = data.edgecenter_project.pr.client_id
+  threshold = 80
+}
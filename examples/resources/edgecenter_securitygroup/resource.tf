@@ -28,4 +28,20 @@ resource "edgecenter_securitygroup" "sg" {
     ethertype = "IPv4"
     protocol  = "vrrp"
   }
+
+  security_group_rules {
+    direction = "ingress"
+    ethertype = "IPv4"
+    protocol  = "icmp"
+    icmp_type = 8
+    icmp_code = 0
+  }
+}
+
+resource "edgecenter_securitygroup" "sg_copy" {
+  name       = "test sg copy"
+  region_id  = 1
+  project_id = 1
+
+  copy_rules_from_securitygroup_id = edgecenter_securitygroup.sg.id
 }
@@ -0,0 +1,2 @@
+# import using <project_id>:<region_id>:<lifecyclepolicy_id>/<volume_id>,<volume_id> format
+terraform import edgecenter_snapshot_policy_assignment.spa1 1:6:7/fe93bfdd-4ce3-4041-b89b-4f10d0d49498,726ecfcc-7fd0-4e30-a86e-7892524aa483
\ No newline at end of file
@@ -0,0 +1,30 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+resource "edgecenter_lifecyclepolicy" "lp" {
+  project_id = 1
+  region_id  = 1
+  name       = "daily"
+  schedule {
+    max_quantity = 7
+    cron {
+      timezone = "Europe/London"
+      hour     = "2"
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [volume]
+  }
+}
+
+resource "edgecenter_snapshot_policy_assignment" "spa" {
+  project_id          = 1
+  region_id           = 1
+  lifecycle_policy_id = edgecenter_lifecyclepolicy.lp.id
+  volume_ids = [
+    "fe93bfdd-4ce3-4041-b89b-4f10d0d49498",
+    "726ecfcc-7fd0-4e30-a86e-7892524aa483",
+  ]
+}
@@ -0,0 +1,13 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+resource "edgecenter_storage_s3_bucket" "example_s3_bucket" {
+  name       = "example1bucket2name"
+  storage_id = 1
+}
+
+resource "edgecenter_storage_s3_bucket_policy" "example_s3_bucket_policy" {
+  storage_id  = edgecenter_storage_s3_bucket.example_s3_bucket.storage_id
+  bucket_name = edgecenter_storage_s3_bucket.example_s3_bucket.name
+}
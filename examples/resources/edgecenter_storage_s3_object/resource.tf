@@ -0,0 +1,36 @@
+provider "edgecenter" {
+  permanent_api_token = "251$d3361.............1b35f26d8"
+}
+
+resource "edgecenter_storage_s3" "example_s3" {
+  name     = "example"
+  location = "s-ed1"
+}
+
+resource "edgecenter_storage_s3_bucket" "example_s3_bucket" {
+  name       = "example1bucket2name"
+  storage_id = edgecenter_storage_s3.example_s3.id
+}
+
+resource "edgecenter_storage_s3_object" "bootstrap" {
+  storage_id  = edgecenter_storage_s3.example_s3.id
+  bucket_name = edgecenter_storage_s3_bucket.example_s3_bucket.name
+  access_key  = edgecenter_storage_s3.example_s3.generated_access_key
+  secret_key  = edgecenter_storage_s3.example_s3.generated_secret_key
+
+  key          = "scripts/bootstrap.sh"
+  source       = "${path.module}/bootstrap.sh"
+  content_type = "text/x-shellscript"
+}
+
+resource "edgecenter_storage_s3_object" "error_page" {
+  storage_id  = edgecenter_storage_s3.example_s3.id
+  bucket_name = edgecenter_storage_s3_bucket.example_s3_bucket.name
+  access_key  = edgecenter_storage_s3.example_s3.generated_access_key
+  secret_key  = edgecenter_storage_s3.example_s3.generated_secret_key
+
+  key           = "errors/404.html"
+  content       = "<html><body><h1>Not Found</h1></body></html>"
+  content_type  = "text/html"
+  cache_control = "max-age=3600"
+}